@@ -9,18 +9,25 @@ package a2aclient
 import (
 	"bytes"
 	"context"
-	"crypto/tls"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"math"
+	"math/rand"
+	"net"
 	"net/http"
 	"net/url"
+	"os"
+	"strconv"
+	"strings"
 	"sync"
+	"syscall"
 	"time"
 
-	"github.com/gorilla/websocket"
 	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/oauth2"
 )
 
 // Core Configuration Types
@@ -31,33 +38,205 @@ type A2ACertificate struct {
 	KeyFile    string `json:"key_file"`
 	CAFile     string `json:"ca_file,omitempty"`
 	Passphrase string `json:"passphrase,omitempty"`
+	// WatchCertificates re-reads CertFile and KeyFile when they change, so
+	// new connections present a rotated certificate without a restart
+	WatchCertificates bool `json:"watch_certificates,omitempty"`
 }
 
 // RetryPolicy defines retry behavior configuration
 type RetryPolicy struct {
-	MaxRetries       int           `json:"max_retries"`
-	BackoffStrategy  string        `json:"backoff_strategy"` // "linear", "exponential", "custom"
-	BaseDelay        time.Duration `json:"base_delay"`
-	MaxDelay         time.Duration `json:"max_delay"`
-	RetryableErrors  []string      `json:"retryable_errors"`
+	MaxRetries      int           `json:"max_retries"`
+	BackoffStrategy string        `json:"backoff_strategy"` // "linear", "exponential", "custom"
+	BaseDelay       time.Duration `json:"base_delay"`
+	MaxDelay        time.Duration `json:"max_delay"`
+	// RetryableErrors lists the error codes to retry. HTTP 5xx responses
+	// (SERVER_ERROR) are retried, and fail over to the next endpoint, only
+	// when listed here.
+	RetryableErrors []string `json:"retryable_errors"`
+	// CustomBackoff computes the delay before the retry following the given
+	// zero-based attempt when BackoffStrategy is "custom". The result is
+	// clamped to MaxDelay; zero or negative retries immediately. Without it,
+	// "custom" falls back to linear backoff.
+	CustomBackoff func(attempt int, baseDelay, maxDelay time.Duration) time.Duration `json:"-"`
+	// Jitter randomizes backoff delays so clients retrying together spread
+	// out: "full" waits a uniform random delay in [0, delay], "equal" waits
+	// delay/2 plus a random part of the other half, and "none" (default)
+	// waits the delay as computed. Server Retry-After delays are not
+	// jittered.
+	Jitter string `json:"jitter,omitempty"`
+	// JitterSource returns uniform random numbers in [0, 1) for Jitter
+	// (default math/rand)
+	JitterSource func() float64 `json:"-"`
+	// TotalTimeout caps the time spent on all attempts of a request,
+	// backoff waits included; zero means no cap. Each attempt is cut short
+	// at the end of the budget, and no retry is started that could not
+	// begin before it. Running out fails with RETRY_BUDGET_EXCEEDED carrying
+	// the last error.
+	TotalTimeout time.Duration `json:"total_timeout,omitempty"`
 }
 
 // LoggingConfig defines logging behavior
 type LoggingConfig struct {
-	Level                  string `json:"level"` // "DEBUG", "INFO", "WARN", "ERROR"
-	EnableRequestLogging   bool   `json:"enable_request_logging"`
-	EnableResponseLogging  bool   `json:"enable_response_logging"`
+	Level                 string           `json:"level"` // "DEBUG", "INFO", "WARN", "ERROR"
+	EnableRequestLogging  bool             `json:"enable_request_logging"`
+	EnableResponseLogging bool             `json:"enable_response_logging"`
+	Redaction             *RedactionPolicy `json:"redaction,omitempty"`
 }
 
 // A2AClientConfig is the main client configuration
 type A2AClientConfig struct {
-	BaseURL           string             `json:"base_url"`
-	APIKey            string             `json:"api_key,omitempty"`
-	Certificate       *A2ACertificate    `json:"certificate,omitempty"`
-	Timeout           time.Duration      `json:"timeout"`
-	RetryPolicy       *RetryPolicy       `json:"retry_policy"`
-	WebSocketEnabled  bool               `json:"websocket_enabled"`
-	Logging           *LoggingConfig     `json:"logging"`
+	BaseURL string `json:"base_url"`
+	// BaseURLs lists endpoints to spread requests over and fail over
+	// between, chosen by EndpointStrategy (default
+	// "primary-with-failover"). BaseURL is shorthand for a single endpoint.
+	// A transport failure marks an endpoint unhealthy per EndpointHealth and
+	// the request's retries move on to the next endpoint.
+	BaseURLs         []string              `json:"base_urls,omitempty"`
+	EndpointStrategy string                `json:"endpoint_strategy,omitempty"` // "primary-with-failover", "round-robin", "random"
+	EndpointHealth   *EndpointHealthConfig `json:"endpoint_health,omitempty"`
+	APIKey           string                `json:"api_key,omitempty"`
+	Certificate      *A2ACertificate       `json:"certificate,omitempty"`
+	// TokenSource, if set, supplies an OAuth2 bearer token for every HTTP
+	// request and WebSocket dial, sent alongside APIKey. The token is
+	// reused until it expires; a 401 response forces one refresh and retry.
+	TokenSource oauth2.TokenSource `json:"-"`
+	Timeout     time.Duration      `json:"timeout"`
+	// DisableHTTP2 keeps HTTP requests on HTTP/1.1, e.g. to inspect traffic
+	// with tools that do not speak HTTP/2. By default HTTP/2 is negotiated
+	// over TLS when the server supports it.
+	DisableHTTP2 bool `json:"disable_http2,omitempty"`
+	// HTTPTransport replaces the http.Transport the client builds for HTTP
+	// requests, e.g. for custom dialing or a recording fake in tests. An
+	// *http.Transport without a TLSClientConfig gets Certificate applied to
+	// a copy of it; any other RoundTripper is used as it is. DisableHTTP2
+	// is ignored. WebSocket connections do not use it.
+	HTTPTransport http.RoundTripper `json:"-"`
+	// ProxyURL routes HTTP requests and WebSocket connections through an
+	// http:// or socks5:// proxy, credentials taken from its user info.
+	ProxyURL string `json:"proxy_url,omitempty"`
+	// Proxy chooses the proxy per request, overriding ProxyURL; return a nil
+	// URL to connect directly. Set it to http.ProxyFromEnvironment to honor
+	// HTTP_PROXY, HTTPS_PROXY and NO_PROXY. By default no proxy is used.
+	// Neither applies to an HTTPTransport other than an *http.Transport
+	// with no Proxy of its own.
+	Proxy func(*http.Request) (*url.URL, error) `json:"-"`
+	// CompressionThreshold gzips HTTP request bodies larger than this many
+	// bytes and, if the server supports permessage-deflate, compresses
+	// WebSocket frames above it; zero disables request compression.
+	// Compressed HTTP responses are decompressed either way.
+	CompressionThreshold int          `json:"compression_threshold,omitempty"`
+	RetryPolicy          *RetryPolicy `json:"retry_policy"`
+	WebSocketEnabled     bool         `json:"websocket_enabled"`
+	// ReplayOnReconnect resends requests still awaiting a response when
+	// their WebSocket connection drops, on another pooled connection or,
+	// with ReconnectEnabled, once reconnected, instead of failing them with
+	// CONNECTION_LOST. Replays keep the message ID and IdempotencyKey so the
+	// server can discard duplicates, and are not made once the message's
+	// TTL has run out. At most ReplayBufferSize (default 100) requests wait
+	// for replay at once; the rest fail as before. ReplayStrict only replays
+	// messages with direct or no coordination.
+	ReplayOnReconnect bool `json:"replay_on_reconnect,omitempty"`
+	ReplayBufferSize  int  `json:"replay_buffer_size,omitempty"`
+	ReplayStrict      bool `json:"replay_strict,omitempty"`
+	// WebSocketPoolSize is the number of parallel WebSocket connections
+	// (default 1). Messages are spread over them round-robin; each connection
+	// has its own keepalive and is reconnected on its own.
+	WebSocketPoolSize int            `json:"websocket_pool_size,omitempty"`
+	Logging           *LoggingConfig `json:"logging"`
+	// Logger receives the client's log records at or above Logging.Level:
+	// retries, connection events and failures, plus requests and responses
+	// when enabled. Defaults to NopLogger; use SlogLogger for log/slog.
+	Logger         Logger                `json:"-"`
+	ReconnectQueue *ReconnectQueueConfig `json:"reconnect_queue,omitempty"`
+	// NamespaceConsistency sets the default memory consistency per namespace,
+	// used when a StoreMemory/RetrieveMemory call leaves Consistency empty
+	NamespaceConsistency map[string]string `json:"namespace_consistency,omitempty"`
+	// DefaultConsistency applies to namespaces without an entry (default "eventual")
+	DefaultConsistency string `json:"default_consistency,omitempty"`
+	// OnConnectionEvent is notified of each attempt made by Connect and of
+	// connection loss and automatic reconnection
+	OnConnectionEvent func(ConnectionEvent) `json:"-"`
+	// OnStateChange is called with the old and new state whenever the
+	// connection state changes. Calls are made one at a time, in order, and
+	// never with the client's locks held.
+	OnStateChange func(old, new ConnectionState) `json:"-"`
+	// FallbackToHTTP sends over HTTP while the WebSocket is down, whether
+	// the dial failed or a reconnection is under way, instead of buffering
+	// in ReconnectQueue. Sends return to the WebSocket once it is
+	// re-established by ReconnectEnabled or Connect. Streams do not fall
+	// back; they fail with WEBSOCKET_REQUIRED while the socket is down.
+	FallbackToHTTP bool `json:"fallback_to_http,omitempty"`
+	// ReconnectEnabled re-dials the WebSocket automatically when the
+	// connection drops, using ReconnectPolicy
+	ReconnectEnabled bool             `json:"reconnect_enabled,omitempty"`
+	ReconnectPolicy  *ReconnectPolicy `json:"reconnect_policy,omitempty"`
+	// PingInterval is how often a keepalive ping is sent on the WebSocket
+	// (default 30s, negative disables keepalive). If nothing, including the
+	// pong, is read within PingInterval+PongTimeout (default 10s), the
+	// connection is treated as dead and reported as ConnectionEventStale.
+	PingInterval time.Duration `json:"ping_interval,omitempty"`
+	PongTimeout  time.Duration `json:"pong_timeout,omitempty"`
+	// CircuitBreaker fails sends fast with CIRCUIT_OPEN after repeated
+	// transport failures; nil disables it
+	CircuitBreaker *CircuitBreakerConfig `json:"circuit_breaker,omitempty"`
+	// Metrics receives request, retry, latency and error observations; nil
+	// disables metrics. See the prommetrics package for a Prometheus adapter.
+	Metrics MetricsHook `json:"-"`
+	// TracerProvider enables OpenTelemetry tracing: each SendMessage gets a
+	// client span and W3C trace context is sent with the message. Nil
+	// disables tracing.
+	TracerProvider trace.TracerProvider `json:"-"`
+	// RateLimit throttles sends, retries included, with a token bucket.
+	// RateLimiter, if set, is used instead.
+	RateLimit   *RateLimitConfig `json:"rate_limit,omitempty"`
+	RateLimiter RateLimiter      `json:"-"`
+	// MaxInFlight caps the number of requests awaiting a response at once;
+	// zero means no limit. Requests over the cap wait and are dispatched
+	// highest Priority first, then in the order they were sent. Only waiting
+	// requests are reordered; those already in flight are never preempted.
+	// Unlike RateLimit it bounds concurrency rather than throughput; see
+	// InFlight for the current count.
+	MaxInFlight int `json:"max_in_flight,omitempty"`
+	// ResponseCache serves repeated reads of read-only tools from a local
+	// cache; nil disables it
+	ResponseCache *ResponseCacheConfig `json:"response_cache,omitempty"`
+	// Interceptors wrap every SendMessage call, the first outermost; see
+	// Interceptor
+	Interceptors []Interceptor `json:"-"`
+	// Transport replaces the built-in WebSocket/HTTP transport, e.g. with a
+	// gRPC backend or a MemoryTransport in tests
+	Transport Transport `json:"-"`
+	// MaxFrameBytes limits the size of a WebSocket frame read from the server
+	// (default 32 MiB). Larger frames are discarded without dropping the
+	// connection and fail the matching request with FRAME_TOO_LARGE.
+	MaxFrameBytes int64 `json:"max_frame_bytes,omitempty"`
+	// MaxResponseBytes limits the size of an HTTP response body decoded by
+	// SendMessage (default 32 MiB). Larger responses fail with
+	// RESPONSE_TOO_LARGE.
+	MaxResponseBytes int64 `json:"max_response_bytes,omitempty"`
+	// Codec encodes messages and decodes responses on the wire (default
+	// JSONCodec). Server-Sent Event streams and SendMessageToWriter results
+	// are always JSON.
+	Codec Codec `json:"-"`
+	// MaxStreamedResultBytes is a sanity ceiling on the result size
+	// SendMessageToWriter will copy (default 16 GiB)
+	MaxStreamedResultBytes int64 `json:"max_streamed_result_bytes,omitempty"`
+	// InheritEnv names client process environment variables copied into
+	// every message's execution environment, unless the message sets them
+	InheritEnv []string `json:"inherit_env,omitempty"`
+	// QueueWhenOffline stores messages sent while the client is not
+	// connected in OfflineQueue (default in memory) and returns ErrQueued;
+	// they are sent on the next successful connect, highest Priority first
+	// and oldest first within a priority, with each outcome reported to
+	// OnQueuedResult
+	QueueWhenOffline bool                                                        `json:"queue_when_offline,omitempty"`
+	OfflineQueue     OfflineQueue                                                `json:"-"`
+	OnQueuedResult   func(message *A2AMessage, response *A2AResponse, err error) `json:"-"`
+	// OnDeadLetter is called once for each message the client gives up on:
+	// retries or the retry budget exhausted, a non-retryable failure, an
+	// open circuit or a TTL that ran out before it was sent. It is not called
+	// for messages abandoned because the caller's context ended.
+	OnDeadLetter func(message *A2AMessage, lastErr error) `json:"-"`
 }
 
 // Agent and Targeting Types
@@ -112,7 +291,7 @@ type SingleTarget struct {
 type MultipleTargets struct {
 	Type             string   `json:"type"` // "multiple"
 	AgentIDs         []string `json:"agent_ids"`
-	CoordinationMode string   `json:"coordination_mode"` // "parallel", "sequential", "race"
+	CoordinationMode string   `json:"coordination_mode"` // "parallel", "sequential", "race"; see RaceTargets to race on the client
 }
 
 // GroupTarget targets agents by role/capabilities
@@ -133,9 +312,9 @@ type BroadcastTarget struct {
 
 // ConditionalTarget targets agents based on conditions
 type ConditionalTarget struct {
-	Type       string            `json:"type"` // "conditional"
-	Conditions []AgentCondition  `json:"conditions"`
-	Fallback   *AgentTarget      `json:"fallback,omitempty"`
+	Type       string           `json:"type"` // "conditional"
+	Conditions []AgentCondition `json:"conditions"`
+	Fallback   *AgentTarget     `json:"fallback,omitempty"`
 }
 
 // AgentTarget is a union type for all targeting options
@@ -159,7 +338,7 @@ type DirectCoordination struct {
 
 // BroadcastCoordination represents 1-to-many broadcast coordination
 type BroadcastCoordination struct {
-	Mode           string `json:"mode"` // "broadcast"
+	Mode           string `json:"mode"`        // "broadcast"
 	Aggregation    string `json:"aggregation"` // "all", "majority", "first", "any"
 	Timeout        *int   `json:"timeout,omitempty"`
 	PartialSuccess bool   `json:"partial_success,omitempty"`
@@ -167,7 +346,7 @@ type BroadcastCoordination struct {
 
 // ConsensusCoordination represents many-to-many consensus coordination
 type ConsensusCoordination struct {
-	Mode                string `json:"mode"` // "consensus"
+	Mode                string `json:"mode"`           // "consensus"
 	ConsensusType       string `json:"consensus_type"` // "unanimous", "majority", "weighted"
 	VotingTimeout       *int   `json:"voting_timeout,omitempty"`
 	MinimumParticipants *int   `json:"minimum_participants,omitempty"`
@@ -182,14 +361,17 @@ type PipelineStage struct {
 	InputTransform  string       `json:"input_transform,omitempty"`
 	OutputTransform string       `json:"output_transform,omitempty"`
 	Timeout         *int         `json:"timeout,omitempty"`
+	// DependsOn names the stages that must finish before this one runs;
+	// see RunPipelineDAG
+	DependsOn []string `json:"depends_on,omitempty"`
 }
 
 // PipelineCoordination represents sequential pipeline coordination
 type PipelineCoordination struct {
-	Mode             string           `json:"mode"` // "pipeline"
-	Stages           []PipelineStage  `json:"stages"`
-	FailureStrategy  string           `json:"failure_strategy"` // "abort", "skip", "retry"
-	StatePassthrough bool             `json:"state_passthrough"`
+	Mode             string          `json:"mode"` // "pipeline"
+	Stages           []PipelineStage `json:"stages"`
+	FailureStrategy  string          `json:"failure_strategy"` // "abort", "skip", "retry"
+	StatePassthrough bool            `json:"state_passthrough"`
 }
 
 // CoordinationMode is a union type for all coordination modes
@@ -206,7 +388,7 @@ type MessagePriority string
 const (
 	MessagePriorityLow      MessagePriority = "low"
 	MessagePriorityMedium   MessagePriority = "medium"
-	MessagePriorityHigh     MessagePriority = "high" 
+	MessagePriorityHigh     MessagePriority = "high"
 	MessagePriorityCritical MessagePriority = "critical"
 )
 
@@ -215,52 +397,52 @@ type MCPToolName string
 
 const (
 	// Core Infrastructure (16 tools)
-	MCPToolClaudeFlowSwarmInit       MCPToolName = "mcp__gemini-flow__swarm_init"
-	MCPToolClaudeFlowSwarmStatus     MCPToolName = "mcp__gemini-flow__swarm_status"
-	MCPToolClaudeFlowSwarmMonitor    MCPToolName = "mcp__gemini-flow__swarm_monitor"
-	MCPToolClaudeFlowSwarmScale      MCPToolName = "mcp__gemini-flow__swarm_scale"
-	MCPToolClaudeFlowSwarmDestroy    MCPToolName = "mcp__gemini-flow__swarm_destroy"
-	MCPToolRuvSwarmSwarmInit         MCPToolName = "mcp__ruv-swarm__swarm_init"
-	MCPToolRuvSwarmSwarmStatus       MCPToolName = "mcp__ruv-swarm__swarm_status"
-	MCPToolRuvSwarmSwarmMonitor      MCPToolName = "mcp__ruv-swarm__swarm_monitor"
-	MCPToolClaudeFlowAgentSpawn      MCPToolName = "mcp__gemini-flow__agent_spawn"
-	MCPToolClaudeFlowAgentList       MCPToolName = "mcp__gemini-flow__agent_list"
-	MCPToolClaudeFlowAgentMetrics    MCPToolName = "mcp__gemini-flow__agent_metrics"
-	MCPToolRuvSwarmAgentSpawn        MCPToolName = "mcp__ruv-swarm__agent_spawn"
-	MCPToolRuvSwarmAgentList         MCPToolName = "mcp__ruv-swarm__agent_list"
-	MCPToolRuvSwarmAgentMetrics      MCPToolName = "mcp__ruv-swarm__agent_metrics"
+	MCPToolClaudeFlowSwarmInit        MCPToolName = "mcp__gemini-flow__swarm_init"
+	MCPToolClaudeFlowSwarmStatus      MCPToolName = "mcp__gemini-flow__swarm_status"
+	MCPToolClaudeFlowSwarmMonitor     MCPToolName = "mcp__gemini-flow__swarm_monitor"
+	MCPToolClaudeFlowSwarmScale       MCPToolName = "mcp__gemini-flow__swarm_scale"
+	MCPToolClaudeFlowSwarmDestroy     MCPToolName = "mcp__gemini-flow__swarm_destroy"
+	MCPToolRuvSwarmSwarmInit          MCPToolName = "mcp__ruv-swarm__swarm_init"
+	MCPToolRuvSwarmSwarmStatus        MCPToolName = "mcp__ruv-swarm__swarm_status"
+	MCPToolRuvSwarmSwarmMonitor       MCPToolName = "mcp__ruv-swarm__swarm_monitor"
+	MCPToolClaudeFlowAgentSpawn       MCPToolName = "mcp__gemini-flow__agent_spawn"
+	MCPToolClaudeFlowAgentList        MCPToolName = "mcp__gemini-flow__agent_list"
+	MCPToolClaudeFlowAgentMetrics     MCPToolName = "mcp__gemini-flow__agent_metrics"
+	MCPToolRuvSwarmAgentSpawn         MCPToolName = "mcp__ruv-swarm__agent_spawn"
+	MCPToolRuvSwarmAgentList          MCPToolName = "mcp__ruv-swarm__agent_list"
+	MCPToolRuvSwarmAgentMetrics       MCPToolName = "mcp__ruv-swarm__agent_metrics"
 	MCPToolClaudeFlowTopologyOptimize MCPToolName = "mcp__gemini-flow__topology_optimize"
 	MCPToolClaudeFlowCoordinationSync MCPToolName = "mcp__gemini-flow__coordination_sync"
 
 	// Task Orchestration (12 tools)
-	MCPToolClaudeFlowTaskOrchestrate  MCPToolName = "mcp__gemini-flow__task_orchestrate"
-	MCPToolClaudeFlowTaskStatus       MCPToolName = "mcp__gemini-flow__task_status"
-	MCPToolClaudeFlowTaskResults      MCPToolName = "mcp__gemini-flow__task_results"
-	MCPToolRuvSwarmTaskOrchestrate    MCPToolName = "mcp__ruv-swarm__task_orchestrate"
-	MCPToolRuvSwarmTaskStatus         MCPToolName = "mcp__ruv-swarm__task_status"
-	MCPToolRuvSwarmTaskResults        MCPToolName = "mcp__ruv-swarm__task_results"
-	MCPToolClaudeFlowParallelExecute  MCPToolName = "mcp__gemini-flow__parallel_execute"
-	MCPToolClaudeFlowBatchProcess     MCPToolName = "mcp__gemini-flow__batch_process"
-	MCPToolClaudeFlowLoadBalance      MCPToolName = "mcp__gemini-flow__load_balance"
-	MCPToolClaudeFlowWorkflowCreate   MCPToolName = "mcp__gemini-flow__workflow_create"
-	MCPToolClaudeFlowWorkflowExecute  MCPToolName = "mcp__gemini-flow__workflow_execute"
-	MCPToolClaudeFlowWorkflowExport   MCPToolName = "mcp__gemini-flow__workflow_export"
+	MCPToolClaudeFlowTaskOrchestrate MCPToolName = "mcp__gemini-flow__task_orchestrate"
+	MCPToolClaudeFlowTaskStatus      MCPToolName = "mcp__gemini-flow__task_status"
+	MCPToolClaudeFlowTaskResults     MCPToolName = "mcp__gemini-flow__task_results"
+	MCPToolRuvSwarmTaskOrchestrate   MCPToolName = "mcp__ruv-swarm__task_orchestrate"
+	MCPToolRuvSwarmTaskStatus        MCPToolName = "mcp__ruv-swarm__task_status"
+	MCPToolRuvSwarmTaskResults       MCPToolName = "mcp__ruv-swarm__task_results"
+	MCPToolClaudeFlowParallelExecute MCPToolName = "mcp__gemini-flow__parallel_execute"
+	MCPToolClaudeFlowBatchProcess    MCPToolName = "mcp__gemini-flow__batch_process"
+	MCPToolClaudeFlowLoadBalance     MCPToolName = "mcp__gemini-flow__load_balance"
+	MCPToolClaudeFlowWorkflowCreate  MCPToolName = "mcp__gemini-flow__workflow_create"
+	MCPToolClaudeFlowWorkflowExecute MCPToolName = "mcp__gemini-flow__workflow_execute"
+	MCPToolClaudeFlowWorkflowExport  MCPToolName = "mcp__gemini-flow__workflow_export"
 
 	// Memory & State Management (14 tools)
-	MCPToolClaudeFlowMemoryUsage      MCPToolName = "mcp__gemini-flow__memory_usage"
-	MCPToolClaudeFlowMemorySearch     MCPToolName = "mcp__gemini-flow__memory_search"
-	MCPToolClaudeFlowMemoryPersist    MCPToolName = "mcp__gemini-flow__memory_persist"
-	MCPToolClaudeFlowMemoryNamespace  MCPToolName = "mcp__gemini-flow__memory_namespace"
-	MCPToolClaudeFlowMemoryBackup     MCPToolName = "mcp__gemini-flow__memory_backup"
-	MCPToolClaudeFlowMemoryRestore    MCPToolName = "mcp__gemini-flow__memory_restore"
-	MCPToolClaudeFlowMemoryCompress   MCPToolName = "mcp__gemini-flow__memory_compress"
-	MCPToolClaudeFlowMemorySync       MCPToolName = "mcp__gemini-flow__memory_sync"
-	MCPToolClaudeFlowMemoryAnalytics  MCPToolName = "mcp__gemini-flow__memory_analytics"
-	MCPToolRuvSwarmMemoryUsage        MCPToolName = "mcp__ruv-swarm__memory_usage"
-	MCPToolClaudeFlowStateSnapshot    MCPToolName = "mcp__gemini-flow__state_snapshot"
-	MCPToolClaudeFlowContextRestore   MCPToolName = "mcp__gemini-flow__context_restore"
-	MCPToolClaudeFlowCacheManage      MCPToolName = "mcp__gemini-flow__cache_manage"
-	MCPToolClaudeFlowConfigManage     MCPToolName = "mcp__gemini-flow__config_manage"
+	MCPToolClaudeFlowMemoryUsage     MCPToolName = "mcp__gemini-flow__memory_usage"
+	MCPToolClaudeFlowMemorySearch    MCPToolName = "mcp__gemini-flow__memory_search"
+	MCPToolClaudeFlowMemoryPersist   MCPToolName = "mcp__gemini-flow__memory_persist"
+	MCPToolClaudeFlowMemoryNamespace MCPToolName = "mcp__gemini-flow__memory_namespace"
+	MCPToolClaudeFlowMemoryBackup    MCPToolName = "mcp__gemini-flow__memory_backup"
+	MCPToolClaudeFlowMemoryRestore   MCPToolName = "mcp__gemini-flow__memory_restore"
+	MCPToolClaudeFlowMemoryCompress  MCPToolName = "mcp__gemini-flow__memory_compress"
+	MCPToolClaudeFlowMemorySync      MCPToolName = "mcp__gemini-flow__memory_sync"
+	MCPToolClaudeFlowMemoryAnalytics MCPToolName = "mcp__gemini-flow__memory_analytics"
+	MCPToolRuvSwarmMemoryUsage       MCPToolName = "mcp__ruv-swarm__memory_usage"
+	MCPToolClaudeFlowStateSnapshot   MCPToolName = "mcp__gemini-flow__state_snapshot"
+	MCPToolClaudeFlowContextRestore  MCPToolName = "mcp__gemini-flow__context_restore"
+	MCPToolClaudeFlowCacheManage     MCPToolName = "mcp__gemini-flow__cache_manage"
+	MCPToolClaudeFlowConfigManage    MCPToolName = "mcp__gemini-flow__config_manage"
 
 	// Neural & AI Operations (17 tools)
 	MCPToolClaudeFlowNeuralStatus     MCPToolName = "mcp__gemini-flow__neural_status"
@@ -282,74 +464,74 @@ const (
 	MCPToolClaudeFlowTransferLearn    MCPToolName = "mcp__gemini-flow__transfer_learn"
 
 	// DAA Systems (18 tools)
-	MCPToolClaudeFlowDAAAgentCreate     MCPToolName = "mcp__gemini-flow__daa_agent_create"
-	MCPToolClaudeFlowDAACapabilityMatch MCPToolName = "mcp__gemini-flow__daa_capability_match"
-	MCPToolClaudeFlowDAAResourceAlloc   MCPToolName = "mcp__gemini-flow__daa_resource_alloc"
-	MCPToolClaudeFlowDAALifecycleManage MCPToolName = "mcp__gemini-flow__daa_lifecycle_manage"
-	MCPToolClaudeFlowDAACommunication   MCPToolName = "mcp__gemini-flow__daa_communication"
-	MCPToolClaudeFlowDAAConsensus       MCPToolName = "mcp__gemini-flow__daa_consensus"
-	MCPToolClaudeFlowDAAFaultTolerance  MCPToolName = "mcp__gemini-flow__daa_fault_tolerance"
-	MCPToolClaudeFlowDAAOptimization    MCPToolName = "mcp__gemini-flow__daa_optimization"
-	MCPToolRuvSwarmDAAInit              MCPToolName = "mcp__ruv-swarm__daa_init"
-	MCPToolRuvSwarmDAAAgentCreate       MCPToolName = "mcp__ruv-swarm__daa_agent_create"
-	MCPToolRuvSwarmDAAAgentAdapt        MCPToolName = "mcp__ruv-swarm__daa_agent_adapt"
-	MCPToolRuvSwarmDAAWorkflowCreate    MCPToolName = "mcp__ruv-swarm__daa_workflow_create"
-	MCPToolRuvSwarmDAAWorkflowExecute   MCPToolName = "mcp__ruv-swarm__daa_workflow_execute"
-	MCPToolRuvSwarmDAAKnowledgeShare    MCPToolName = "mcp__ruv-swarm__daa_knowledge_share"
-	MCPToolRuvSwarmDAALearningStatus    MCPToolName = "mcp__ruv-swarm__daa_learning_status"
-	MCPToolRuvSwarmDAACognitivePattern  MCPToolName = "mcp__ruv-swarm__daa_cognitive_pattern"
-	MCPToolRuvSwarmDAAMetaLearning      MCPToolName = "mcp__ruv-swarm__daa_meta_learning"
+	MCPToolClaudeFlowDAAAgentCreate      MCPToolName = "mcp__gemini-flow__daa_agent_create"
+	MCPToolClaudeFlowDAACapabilityMatch  MCPToolName = "mcp__gemini-flow__daa_capability_match"
+	MCPToolClaudeFlowDAAResourceAlloc    MCPToolName = "mcp__gemini-flow__daa_resource_alloc"
+	MCPToolClaudeFlowDAALifecycleManage  MCPToolName = "mcp__gemini-flow__daa_lifecycle_manage"
+	MCPToolClaudeFlowDAACommunication    MCPToolName = "mcp__gemini-flow__daa_communication"
+	MCPToolClaudeFlowDAAConsensus        MCPToolName = "mcp__gemini-flow__daa_consensus"
+	MCPToolClaudeFlowDAAFaultTolerance   MCPToolName = "mcp__gemini-flow__daa_fault_tolerance"
+	MCPToolClaudeFlowDAAOptimization     MCPToolName = "mcp__gemini-flow__daa_optimization"
+	MCPToolRuvSwarmDAAInit               MCPToolName = "mcp__ruv-swarm__daa_init"
+	MCPToolRuvSwarmDAAAgentCreate        MCPToolName = "mcp__ruv-swarm__daa_agent_create"
+	MCPToolRuvSwarmDAAAgentAdapt         MCPToolName = "mcp__ruv-swarm__daa_agent_adapt"
+	MCPToolRuvSwarmDAAWorkflowCreate     MCPToolName = "mcp__ruv-swarm__daa_workflow_create"
+	MCPToolRuvSwarmDAAWorkflowExecute    MCPToolName = "mcp__ruv-swarm__daa_workflow_execute"
+	MCPToolRuvSwarmDAAKnowledgeShare     MCPToolName = "mcp__ruv-swarm__daa_knowledge_share"
+	MCPToolRuvSwarmDAALearningStatus     MCPToolName = "mcp__ruv-swarm__daa_learning_status"
+	MCPToolRuvSwarmDAACognitivePattern   MCPToolName = "mcp__ruv-swarm__daa_cognitive_pattern"
+	MCPToolRuvSwarmDAAMetaLearning       MCPToolName = "mcp__ruv-swarm__daa_meta_learning"
 	MCPToolRuvSwarmDAAPerformanceMetrics MCPToolName = "mcp__ruv-swarm__daa_performance_metrics"
 
 	// Performance & Analytics (12 tools)
-	MCPToolClaudeFlowPerformanceReport  MCPToolName = "mcp__gemini-flow__performance_report"
-	MCPToolClaudeFlowBottleneckAnalyze  MCPToolName = "mcp__gemini-flow__bottleneck_analyze"
-	MCPToolClaudeFlowTokenUsage         MCPToolName = "mcp__gemini-flow__token_usage"
-	MCPToolClaudeFlowBenchmarkRun       MCPToolName = "mcp__gemini-flow__benchmark_run"
-	MCPToolClaudeFlowMetricsCollect     MCPToolName = "mcp__gemini-flow__metrics_collect"
-	MCPToolClaudeFlowTrendAnalysis      MCPToolName = "mcp__gemini-flow__trend_analysis"
-	MCPToolRuvSwarmBenchmarkRun         MCPToolName = "mcp__ruv-swarm__benchmark_run"
-	MCPToolClaudeFlowCostAnalysis       MCPToolName = "mcp__gemini-flow__cost_analysis"
-	MCPToolClaudeFlowQualityAssess      MCPToolName = "mcp__gemini-flow__quality_assess"
-	MCPToolClaudeFlowErrorAnalysis      MCPToolName = "mcp__gemini-flow__error_analysis"
-	MCPToolClaudeFlowUsageStats         MCPToolName = "mcp__gemini-flow__usage_stats"
-	MCPToolClaudeFlowHealthCheck        MCPToolName = "mcp__gemini-flow__health_check"
+	MCPToolClaudeFlowPerformanceReport MCPToolName = "mcp__gemini-flow__performance_report"
+	MCPToolClaudeFlowBottleneckAnalyze MCPToolName = "mcp__gemini-flow__bottleneck_analyze"
+	MCPToolClaudeFlowTokenUsage        MCPToolName = "mcp__gemini-flow__token_usage"
+	MCPToolClaudeFlowBenchmarkRun      MCPToolName = "mcp__gemini-flow__benchmark_run"
+	MCPToolClaudeFlowMetricsCollect    MCPToolName = "mcp__gemini-flow__metrics_collect"
+	MCPToolClaudeFlowTrendAnalysis     MCPToolName = "mcp__gemini-flow__trend_analysis"
+	MCPToolRuvSwarmBenchmarkRun        MCPToolName = "mcp__ruv-swarm__benchmark_run"
+	MCPToolClaudeFlowCostAnalysis      MCPToolName = "mcp__gemini-flow__cost_analysis"
+	MCPToolClaudeFlowQualityAssess     MCPToolName = "mcp__gemini-flow__quality_assess"
+	MCPToolClaudeFlowErrorAnalysis     MCPToolName = "mcp__gemini-flow__error_analysis"
+	MCPToolClaudeFlowUsageStats        MCPToolName = "mcp__gemini-flow__usage_stats"
+	MCPToolClaudeFlowHealthCheck       MCPToolName = "mcp__gemini-flow__health_check"
 
 	// GitHub Integration (8 tools)
-	MCPToolClaudeFlowGitHubRepoAnalyze   MCPToolName = "mcp__gemini-flow__github_repo_analyze"
-	MCPToolClaudeFlowGitHubMetrics       MCPToolName = "mcp__gemini-flow__github_metrics"
-	MCPToolClaudeFlowGitHubPRManage      MCPToolName = "mcp__gemini-flow__github_pr_manage"
-	MCPToolClaudeFlowGitHubCodeReview    MCPToolName = "mcp__gemini-flow__github_code_review"
-	MCPToolClaudeFlowGitHubIssueTrack    MCPToolName = "mcp__gemini-flow__github_issue_track"
-	MCPToolClaudeFlowGitHubReleaseCoord  MCPToolName = "mcp__gemini-flow__github_release_coord"
-	MCPToolClaudeFlowGitHubWorkflowAuto  MCPToolName = "mcp__gemini-flow__github_workflow_auto"
-	MCPToolClaudeFlowGitHubSyncCoord     MCPToolName = "mcp__gemini-flow__github_sync_coord"
+	MCPToolClaudeFlowGitHubRepoAnalyze  MCPToolName = "mcp__gemini-flow__github_repo_analyze"
+	MCPToolClaudeFlowGitHubMetrics      MCPToolName = "mcp__gemini-flow__github_metrics"
+	MCPToolClaudeFlowGitHubPRManage     MCPToolName = "mcp__gemini-flow__github_pr_manage"
+	MCPToolClaudeFlowGitHubCodeReview   MCPToolName = "mcp__gemini-flow__github_code_review"
+	MCPToolClaudeFlowGitHubIssueTrack   MCPToolName = "mcp__gemini-flow__github_issue_track"
+	MCPToolClaudeFlowGitHubReleaseCoord MCPToolName = "mcp__gemini-flow__github_release_coord"
+	MCPToolClaudeFlowGitHubWorkflowAuto MCPToolName = "mcp__gemini-flow__github_workflow_auto"
+	MCPToolClaudeFlowGitHubSyncCoord    MCPToolName = "mcp__gemini-flow__github_sync_coord"
 
 	// Workflow & Automation (6 tools)
-	MCPToolClaudeFlowAutomationSetup    MCPToolName = "mcp__gemini-flow__automation_setup"
-	MCPToolClaudeFlowPipelineCreate     MCPToolName = "mcp__gemini-flow__pipeline_create"
-	MCPToolClaudeFlowSchedulerManage    MCPToolName = "mcp__gemini-flow__scheduler_manage"
-	MCPToolClaudeFlowTriggerSetup       MCPToolName = "mcp__gemini-flow__trigger_setup"
-	MCPToolClaudeFlowWorkflowTemplate   MCPToolName = "mcp__gemini-flow__workflow_template"
-	MCPToolClaudeFlowSparcMode          MCPToolName = "mcp__gemini-flow__sparc_mode"
+	MCPToolClaudeFlowAutomationSetup  MCPToolName = "mcp__gemini-flow__automation_setup"
+	MCPToolClaudeFlowPipelineCreate   MCPToolName = "mcp__gemini-flow__pipeline_create"
+	MCPToolClaudeFlowSchedulerManage  MCPToolName = "mcp__gemini-flow__scheduler_manage"
+	MCPToolClaudeFlowTriggerSetup     MCPToolName = "mcp__gemini-flow__trigger_setup"
+	MCPToolClaudeFlowWorkflowTemplate MCPToolName = "mcp__gemini-flow__workflow_template"
+	MCPToolClaudeFlowSparcMode        MCPToolName = "mcp__gemini-flow__sparc_mode"
 
 	// System Infrastructure (11 tools)
-	MCPToolClaudeFlowTerminalExecute  MCPToolName = "mcp__gemini-flow__terminal_execute"
-	MCPToolClaudeFlowFeaturesDetect   MCPToolName = "mcp__gemini-flow__features_detect"
-	MCPToolClaudeFlowSecurityScan     MCPToolName = "mcp__gemini-flow__security_scan"
-	MCPToolClaudeFlowBackupCreate     MCPToolName = "mcp__gemini-flow__backup_create"
-	MCPToolClaudeFlowRestoreSystem    MCPToolName = "mcp__gemini-flow__restore_system"
-	MCPToolClaudeFlowLogAnalysis      MCPToolName = "mcp__gemini-flow__log_analysis"
-	MCPToolClaudeFlowDiagnosticRun    MCPToolName = "mcp__gemini-flow__diagnostic_run"
-	MCPToolClaudeFlowWasmOptimize     MCPToolName = "mcp__gemini-flow__wasm_optimize"
-	MCPToolRuvSwarmFeaturesDetect     MCPToolName = "mcp__ruv-swarm__features_detect"
+	MCPToolClaudeFlowTerminalExecute MCPToolName = "mcp__gemini-flow__terminal_execute"
+	MCPToolClaudeFlowFeaturesDetect  MCPToolName = "mcp__gemini-flow__features_detect"
+	MCPToolClaudeFlowSecurityScan    MCPToolName = "mcp__gemini-flow__security_scan"
+	MCPToolClaudeFlowBackupCreate    MCPToolName = "mcp__gemini-flow__backup_create"
+	MCPToolClaudeFlowRestoreSystem   MCPToolName = "mcp__gemini-flow__restore_system"
+	MCPToolClaudeFlowLogAnalysis     MCPToolName = "mcp__gemini-flow__log_analysis"
+	MCPToolClaudeFlowDiagnosticRun   MCPToolName = "mcp__gemini-flow__diagnostic_run"
+	MCPToolClaudeFlowWasmOptimize    MCPToolName = "mcp__gemini-flow__wasm_optimize"
+	MCPToolRuvSwarmFeaturesDetect    MCPToolName = "mcp__ruv-swarm__features_detect"
 )
 
 // State and Resource Requirements
 
 // StateRequirement defines state access requirements
 type StateRequirement struct {
-	Type        string   `json:"type"`        // "read", "write", "exclusive", "shared"
+	Type        string   `json:"type"` // "read", "write", "exclusive", "shared"
 	Namespace   string   `json:"namespace"`
 	Keys        []string `json:"keys"`
 	Consistency string   `json:"consistency"` // "eventual", "strong", "causal"
@@ -358,7 +540,7 @@ type StateRequirement struct {
 
 // ResourceRequirement defines resource requirements
 type ResourceRequirement struct {
-	Type      string          `json:"type"`      // "cpu", "memory", "gpu", "network", "storage", "custom"
+	Type      string          `json:"type"` // "cpu", "memory", "gpu", "network", "storage", "custom"
 	Amount    float64         `json:"amount"`
 	Unit      string          `json:"unit"`
 	Priority  MessagePriority `json:"priority"`
@@ -374,12 +556,21 @@ type AgentIdentifier struct {
 	AgentType    AgentRole `json:"agent_type,omitempty"`
 	SwarmID      string    `json:"swarm_id,omitempty"`
 	Capabilities []string  `json:"capabilities,omitempty"`
+	// Status, Location and Resources are reported by agent listings and
+	// let AgentConditions be evaluated client-side; see MatchAgent
+	Status    string             `json:"status,omitempty"`
+	Location  string             `json:"location,omitempty"`
+	Resources map[string]float64 `json:"resources,omitempty"`
 }
 
 // ExecutionContext defines execution context for messages
 type ExecutionContext struct {
-	Timeout     *int                   `json:"timeout,omitempty"`
-	Priority    *MessagePriority       `json:"priority,omitempty"`
+	Timeout  *int             `json:"timeout,omitempty"`
+	Priority *MessagePriority `json:"priority,omitempty"`
+	// Environment holds environment variables the server sets on the agent's
+	// process for tools that spawn one (terminal_execute, inference_run).
+	// Keys must be valid variable names and values are sent as strings;
+	// see SetEnv.
 	Environment map[string]interface{} `json:"environment,omitempty"`
 	Resources   interface{}            `json:"resources,omitempty"`
 }
@@ -400,15 +591,36 @@ type A2AMessage struct {
 	Timestamp            *int64                 `json:"timestamp,omitempty"`
 	TTL                  *int                   `json:"ttl,omitempty"`
 	Priority             *MessagePriority       `json:"priority,omitempty"`
-	RetryPolicy          *RetryPolicy           `json:"retry_policy,omitempty"`
+	// RetryPolicy overrides the client's retry policy for this message; a
+	// zero MaxDelay and nil RetryableErrors are taken from the client's
+	RetryPolicy *RetryPolicy `json:"retry_policy,omitempty"`
+	// Projection lists dotted result paths (e.g. "status.phase") the server
+	// should return instead of the full result. It is a hint; servers that
+	// ignore it return everything, so use A2AResponse.Project to be sure.
+	Projection []string `json:"projection,omitempty"`
+	// TraceContext carries W3C trace context (traceparent, tracestate) on
+	// WebSocket messages, where there are no headers
+	TraceContext map[string]string `json:"trace_context,omitempty"`
+	// RequestID is the ID logs are correlated by on WebSocket messages,
+	// sent as the X-Request-ID header over HTTP. It is the message ID
+	// unless set on the context with WithRequestID.
+	RequestID string `json:"request_id,omitempty"`
+	// IdempotencyKey lets the server recognise repeated attempts of the same
+	// send and execute it only once. It defaults to the message ID and is
+	// also sent as the Idempotency-Key HTTP header.
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
+
+	// createdAt is when SendMessage was first called with the message; TTL
+	// is enforced from it
+	createdAt time.Time
 }
 
 // ResponseMetadata contains response metadata
 type ResponseMetadata struct {
-	AgentVersion        string      `json:"agent_version,omitempty"`
-	ProcessingTime      *float64    `json:"processing_time,omitempty"`
-	ResourcesUsed       interface{} `json:"resources_used,omitempty"`
-	StateModifications  []interface{} `json:"state_modifications,omitempty"`
+	AgentVersion       string        `json:"agent_version,omitempty"`
+	ProcessingTime     *float64      `json:"processing_time,omitempty"`
+	ResourcesUsed      interface{}   `json:"resources_used,omitempty"`
+	StateModifications []interface{} `json:"state_modifications,omitempty"`
 }
 
 // A2AError represents A2A error information
@@ -431,6 +643,10 @@ type A2AResponse struct {
 	Timestamp     int64                  `json:"timestamp"`
 	Metadata      ResponseMetadata       `json:"metadata"`
 	Performance   map[string]interface{} `json:"performance,omitempty"`
+	Final         bool                   `json:"final,omitempty"` // set on the last response of a stream
+	// Topic is set by the server on events it pushes without a request,
+	// such as agent lifecycle changes or swarm alerts; see Subscribe
+	Topic string `json:"topic,omitempty"`
 }
 
 // Custom Error Types
@@ -440,12 +656,31 @@ type A2AClientError struct {
 	Code    string
 	Message string
 	Details interface{}
+	// Wrapped is the underlying error, such as the net or http failure
+	// behind a transport error, exposed through Unwrap
+	Wrapped error
 }
 
 func (e *A2AClientError) Error() string {
 	return fmt.Sprintf("A2A Error [%s]: %s", e.Code, e.Message)
 }
 
+// Unwrap returns the underlying error, if any
+func (e *A2AClientError) Unwrap() error {
+	return e.Wrapped
+}
+
+// Is reports whether target is an A2AClientError with the same code, so
+// errors.Is matches the Err* sentinels. ErrTimeout also matches network
+// timeouts.
+func (e *A2AClientError) Is(target error) bool {
+	t, ok := target.(*A2AClientError)
+	if !ok {
+		return false
+	}
+	return t.Code == e.Code || (t.Code == CodeTimeout && e.Code == CodeNetworkTimeout)
+}
+
 // NewA2AClientError creates a new A2A client error
 func NewA2AClientError(code, message string, details interface{}) *A2AClientError {
 	return &A2AClientError{
@@ -455,21 +690,145 @@ func NewA2AClientError(code, message string, details interface{}) *A2AClientErro
 	}
 }
 
+// transportError classifies a failure to reach the server so the retry policy
+// can act on it: timeouts become NETWORK_TIMEOUT and refused, reset or dropped
+// connections become CONNECTION_FAILED. Errors caused by the caller's context
+// ending, and anything unrecognised, are wrapped unchanged.
+func transportError(ctx context.Context, action string, err error) error {
+	if ctx.Err() != nil {
+		return fmt.Errorf("failed to %s: %w", action, err)
+	}
+
+	message := fmt.Sprintf("failed to %s: %v", action, err)
+
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || os.IsTimeout(err) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return wrapError(CodeNetworkTimeout, message, err)
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, websocket.ErrCloseSent) {
+		return wrapError(CodeConnectionFailed, message, err)
+	}
+	var closeErr *websocket.CloseError
+	if errors.As(err, &closeErr) {
+		return wrapError(CodeConnectionFailed, message, err)
+	}
+
+	return fmt.Errorf("failed to %s: %w", action, err)
+}
+
+// dialError classifies a failed WebSocket dial so that Connect retries only
+// what may pass on another attempt. Handshakes rejected with 401 or 403 fail
+// with AUTH_FAILED and with 5xx with SERVER_ERROR; other rejections and TLS
+// failures are left uncoded.
+func dialError(ctx context.Context, resp *http.Response, err error) error {
+	if resp != nil {
+		message := fmt.Sprintf("WebSocket handshake failed with status %d", resp.StatusCode)
+		switch {
+		case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+			return wrapError(CodeAuthFailed, message, err)
+		case resp.StatusCode >= http.StatusInternalServerError:
+			return wrapError(CodeServerError, message, err)
+		}
+		return fmt.Errorf("%s: %w", message, err)
+	}
+	if isTLSError(err) {
+		return fmt.Errorf("WebSocket TLS handshake failed: %w", err)
+	}
+	return transportError(ctx, "dial WebSocket", err)
+}
+
+// responseError converts an unsuccessful response into an error, returning
+// nil for successful responses
+func responseError(response *A2AResponse) error {
+	if response.Success {
+		return nil
+	}
+	if response.Error != nil {
+		return NewA2AClientError(response.Error.Code, response.Error.Message, response.Error.Details)
+	}
+	return NewA2AClientError(CodeRequestFailed, "Request was not successful", response.MessageID)
+}
+
 // A2AClient represents the main A2A client
 type A2AClient struct {
-	config         *A2AClientConfig
-	httpClient     *http.Client
-	wsConn         *websocket.Conn
-	wsDialer       *websocket.Dialer
-	messageQueue   map[string]chan *A2AResponse
-	queueMutex     sync.RWMutex
-	connected      bool
-	connectionMux  sync.RWMutex
+	config     *A2AClientConfig
+	httpClient *http.Client
+	transport  Transport
+	send       SendFunc // sendMessage wrapped in the configured interceptors
+	wsDialer   *websocket.Dialer
+	tokens     *tokenCache // nil without a TokenSource
+	pending    sync.Map    // message ID -> *pendingRequest
+	// pendingByCorrelation maps a correlation ID to the first pending
+	// request registered with it
+	pendingByCorrelation sync.Map
+	pendingCount         int64
+	janitorRunning       int32
+	connected            bool
+	connectionLost       bool
+	connectionMux        sync.RWMutex
+	// wsLinks is the WebSocket connection pool; a nil slot is disconnected
+	wsLinks       []*wsLink
+	nextLinkIndex uint32
+	// linksChanged is closed and replaced whenever a connection joins the
+	// pool or the client stops waiting for one
+	linksChanged   chan struct{}
+	replaySlots    chan struct{} // requests waiting for replay, up to ReplayBufferSize
+	reconnectQueue *reconnectQueue
+	circuitBreaker *circuitBreaker
+	toolBreakers   *toolBreakers // per-tool breakers for ExecuteBatch
+	rateLimiter    RateLimiter
+	dispatch       *dispatchQueue
+	endpoints      *endpointSet
+	// subscriptions holds active subscription requests by message ID so
+	// they can be re-sent after a reconnect
+	subscriptions     map[string]*subscription
+	subscriptionMutex sync.Mutex
+	// topics holds the channels subscribed to server-pushed events by topic
+	topics     map[string][]*topicSubscription
+	topicMutex sync.RWMutex
+	// offlineFlushMutex keeps offline queue flushes from overlapping
+	offlineFlushMutex sync.Mutex
+	// Shutdown state: the number of sends in flight, the channel closed when
+	// they reach zero during Shutdown, and the context cancelled to cut them
+	// short
+	lifecycleMutex sync.Mutex
+	connState      stateTracker
+	shuttingDown   bool
+	inFlight       int
+	idle           chan struct{}
+	shutdownCtx    context.Context
+	forceShutdown  context.CancelFunc
+}
+
+// NewA2AClient creates a new A2A client. If the configured certificate or CA
+// file cannot be loaded, the client is created without them, and if
+// ProxyURL is invalid, its requests fail; use NewA2AClientWithError to
+// surface those failures.
+func NewA2AClient(config *A2AClientConfig) *A2AClient {
+	client, _ := newA2AClient(config)
+	return client
 }
 
-// NewA2AClient creates a new A2A client
-func NewA2AClient(config *A2AClientConfig) *A2AClient {
-	// Set defaults
+// NewA2AClientWithError creates a new A2A client, returning an error if the
+// configured certificate or CA file cannot be loaded or ProxyURL is invalid
+func NewA2AClientWithError(config *A2AClientConfig) (*A2AClient, error) {
+	client, err := newA2AClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+// applyDefaults fills in every unset field that has a default
+func (config *A2AClientConfig) applyDefaults() {
 	if config.Timeout == 0 {
 		config.Timeout = 30 * time.Second
 	}
@@ -479,7 +838,7 @@ func NewA2AClient(config *A2AClientConfig) *A2AClient {
 			BackoffStrategy: "exponential",
 			BaseDelay:       1 * time.Second,
 			MaxDelay:        30 * time.Second,
-			RetryableErrors: []string{"NETWORK_TIMEOUT", "CONNECTION_FAILED"},
+			RetryableErrors: append([]string(nil), DefaultRetryableErrors...),
 		}
 	}
 	if config.Logging == nil {
@@ -489,16 +848,142 @@ func NewA2AClient(config *A2AClientConfig) *A2AClient {
 			EnableResponseLogging: false,
 		}
 	}
+	if config.Logger == nil {
+		config.Logger = NopLogger{}
+	}
+
+	if config.MaxFrameBytes <= 0 {
+		config.MaxFrameBytes = 32 << 20
+	}
+	if config.MaxResponseBytes <= 0 {
+		config.MaxResponseBytes = 32 << 20
+	}
+	if config.Codec == nil {
+		config.Codec = JSONCodec{}
+	}
+	if config.WebSocketPoolSize <= 0 {
+		config.WebSocketPoolSize = 1
+	}
+	if config.ReplayBufferSize <= 0 {
+		config.ReplayBufferSize = 100
+	}
+
+	if len(config.BaseURLs) == 0 && config.BaseURL != "" {
+		config.BaseURLs = []string{config.BaseURL}
+	}
+	if config.BaseURL == "" && len(config.BaseURLs) > 0 {
+		config.BaseURL = config.BaseURLs[0]
+	}
+	if config.EndpointStrategy == "" {
+		config.EndpointStrategy = EndpointPrimaryWithFailover
+	}
+	if config.EndpointHealth == nil {
+		config.EndpointHealth = &EndpointHealthConfig{}
+	}
+	if config.EndpointHealth.FailureThreshold <= 0 {
+		config.EndpointHealth.FailureThreshold = 1
+	}
+	if config.EndpointHealth.Window <= 0 {
+		config.EndpointHealth.Window = 30 * time.Second
+	}
+	if config.PingInterval == 0 {
+		config.PingInterval = 30 * time.Second
+	}
+	if config.PongTimeout <= 0 {
+		config.PongTimeout = 10 * time.Second
+	}
+	if config.MaxStreamedResultBytes <= 0 {
+		config.MaxStreamedResultBytes = 16 << 30
+	}
+
+	if config.ReconnectEnabled && config.ReconnectPolicy == nil {
+		config.ReconnectPolicy = &ReconnectPolicy{
+			MaxAttempts:     10,
+			BackoffStrategy: "exponential",
+			BaseDelay:       1 * time.Second,
+			MaxDelay:        30 * time.Second,
+		}
+	}
+
+	if config.ResponseCache != nil {
+		if config.ResponseCache.TTL <= 0 {
+			config.ResponseCache.TTL = 30 * time.Second
+		}
+		if len(config.ResponseCache.Tools) == 0 {
+			config.ResponseCache.Tools = DefaultCacheableTools
+		}
+		if config.ResponseCache.MaxEntries <= 0 {
+			config.ResponseCache.MaxEntries = 1000
+		}
+		if config.ResponseCache.Store == nil {
+			config.ResponseCache.Store = NewMemoryResponseCache(config.ResponseCache.MaxEntries)
+		}
+	}
+
+	if config.CircuitBreaker != nil {
+		if config.CircuitBreaker.FailureThreshold <= 0 {
+			config.CircuitBreaker.FailureThreshold = 5
+		}
+		if config.CircuitBreaker.Cooldown <= 0 {
+			config.CircuitBreaker.Cooldown = 30 * time.Second
+		}
+		if config.CircuitBreaker.HalfOpenProbes <= 0 {
+			config.CircuitBreaker.HalfOpenProbes = 1
+		}
+	}
+
+	if config.RateLimit != nil && config.RateLimit.Burst <= 0 {
+		config.RateLimit.Burst = 1
+	}
+
+	if config.QueueWhenOffline && config.OfflineQueue == nil {
+		config.OfflineQueue = NewMemoryOfflineQueue()
+	}
+
+	if config.ReconnectQueue != nil {
+		if config.ReconnectQueue.MaxSize <= 0 {
+			config.ReconnectQueue.MaxSize = 100
+		}
+		if config.ReconnectQueue.OverflowPolicy == "" {
+			config.ReconnectQueue.OverflowPolicy = "reject"
+		}
+	}
+}
+
+// newA2AClient creates a client, returning it together with any TLS or
+// proxy setup error. On a TLS error the client is usable but has no TLS
+// configuration; on a proxy error its requests fail.
+func newA2AClient(config *A2AClientConfig) (*A2AClient, error) {
+	config.applyDefaults()
 
 	// Setup HTTP client
-	transport := &http.Transport{}
-	if config.Certificate != nil {
-		cert, err := tls.LoadX509KeyPair(config.Certificate.CertFile, config.Certificate.KeyFile)
-		if err == nil {
-			transport.TLSClientConfig = &tls.Config{
-				Certificates: []tls.Certificate{cert},
+	tlsConfig, tlsErr := buildTLSConfig(config.Certificate)
+	if tlsErr != nil {
+		tlsConfig = nil
+	}
+	proxy, proxyErr := proxyFunc(config)
+
+	var transport http.RoundTripper
+	switch custom := config.HTTPTransport.(type) {
+	case nil:
+		transport = &http.Transport{
+			ForceAttemptHTTP2: !config.DisableHTTP2,
+			TLSClientConfig:   tlsConfig,
+			Proxy:             proxy,
+		}
+	case *http.Transport:
+		if (tlsConfig != nil && custom.TLSClientConfig == nil) || (proxy != nil && custom.Proxy == nil) {
+			custom = custom.Clone()
+			if custom.TLSClientConfig == nil {
+				custom.TLSClientConfig = tlsConfig
+			}
+			if custom.Proxy == nil {
+				custom.Proxy = proxy
 			}
 		}
+		transport = custom
+	default:
+		transport = custom
 	}
 
 	httpClient := &http.Client{
@@ -506,104 +991,373 @@ func NewA2AClient(config *A2AClientConfig) *A2AClient {
 		Transport: transport,
 	}
 
-	// Setup WebSocket dialer
+	// Setup WebSocket dialer. It gets its own copy of the TLS config since
+	// the transport adds h2 to the ALPN protocols, which the WebSocket
+	// handshake cannot use.
 	wsDialer := &websocket.Dialer{
-		HandshakeTimeout: config.Timeout,
-		TLSClientConfig:  transport.TLSClientConfig,
+		HandshakeTimeout:  config.Timeout,
+		TLSClientConfig:   tlsConfig.Clone(),
+		EnableCompression: config.CompressionThreshold > 0,
+		Proxy:             proxy,
+	}
+
+	client := &A2AClient{
+		config:        config,
+		httpClient:    httpClient,
+		wsDialer:      wsDialer,
+		wsLinks:       make([]*wsLink, config.WebSocketPoolSize),
+		endpoints:     newEndpointSet(config.BaseURLs, config.EndpointStrategy, *config.EndpointHealth),
+		subscriptions: make(map[string]*subscription),
+		linksChanged:  make(chan struct{}),
+		replaySlots:   make(chan struct{}, config.ReplayBufferSize),
+		topics:        make(map[string][]*topicSubscription),
+		connState:     stateTracker{state: ConnectionStateDisconnected},
+		toolBreakers:  newToolBreakers(),
 	}
+	client.shutdownCtx, client.forceShutdown = context.WithCancel(context.Background())
+	if config.ReconnectQueue != nil {
+		client.reconnectQueue = newReconnectQueue(*config.ReconnectQueue)
+	}
+	if config.CircuitBreaker != nil {
+		client.circuitBreaker = newCircuitBreaker(*config.CircuitBreaker)
+	}
+	if config.TokenSource != nil {
+		client.tokens = &tokenCache{source: config.TokenSource}
+	}
+	if config.RateLimiter != nil {
+		client.rateLimiter = config.RateLimiter
+	} else if config.RateLimit != nil && config.RateLimit.RequestsPerSecond > 0 {
+		client.rateLimiter = newTokenBucket(*config.RateLimit)
+	}
+	client.dispatch = newDispatchQueue(config.MaxInFlight)
+	client.send = chainInterceptors(config.Interceptors, client.sendMessage)
+	client.transport = config.Transport
+	if client.transport == nil {
+		client.transport = defaultTransport{client: client}
+	}
+
+	return client, errors.Join(tlsErr, proxyErr)
+}
+
+// Connection event types reported to OnConnectionEvent
+const (
+	ConnectionEventAttempt   = "attempt"
+	ConnectionEventConnected = "connected"
+	ConnectionEventFailed    = "failed"
+	ConnectionEventLost      = "lost"
+	ConnectionEventStale     = "stale" // no pong within the keepalive window
+)
+
+// ConnectionEvent describes a connection attempt made by Connect or the
+// reconnect supervisor, or the loss of an established connection
+type ConnectionEvent struct {
+	Type    string // one of the ConnectionEvent* constants
+	Attempt int    // 1-based attempt number
+	Err     error  // set for ConnectionEventFailed
+	// Link is the pool index of the WebSocket connection a lost or stale
+	// event, or a single connection's reconnection, refers to
+	Link int
+}
+
+// emitConnectionEvent logs a connection event and reports it to the
+// configured callback
+func (c *A2AClient) emitConnectionEvent(event ConnectionEvent) {
+	switch event.Type {
+	case ConnectionEventFailed:
+		c.log("WARN", "connection attempt failed", "attempt", event.Attempt, "error", event.Err)
+	case ConnectionEventLost, ConnectionEventStale:
+		c.log("WARN", "connection "+event.Type, "link", event.Link, "error", event.Err)
+	case ConnectionEventConnected:
+		c.log("INFO", "connected", "attempt", event.Attempt)
+	default:
+		c.log("DEBUG", "connection attempt", "attempt", event.Attempt)
+	}
+	if c.config.OnConnectionEvent != nil {
+		c.config.OnConnectionEvent(event)
+	}
+}
+
+// Connect establishes connections to the A2A service, retrying failed
+// attempts with the client's retry policy backoff until an attempt succeeds,
+// the retries are exhausted, or ctx is done. Only failures the policy's
+// RetryableErrors accept are retried: an invalid URL, a TLS failure or a
+// handshake rejected with 401 or 403 is returned at once. Use ConnectOnce
+// for a single attempt.
+func (c *A2AClient) Connect(ctx context.Context) (err error) {
+	defer func() {
+		if err != nil {
+			c.failConnecting()
+		}
+	}()
+
+	policy := c.config.RetryPolicy
+	var lastErr error
+
+	for attempt := 0; attempt <= policy.MaxRetries; attempt++ {
+		c.emitConnectionEvent(ConnectionEvent{Type: ConnectionEventAttempt, Attempt: attempt + 1})
+
+		lastErr = c.connectOnce(ctx, c.config.FallbackToHTTP)
+		if lastErr == nil {
+			c.emitConnectionEvent(ConnectionEvent{Type: ConnectionEventConnected, Attempt: attempt + 1})
+			return nil
+		}
+		c.emitConnectionEvent(ConnectionEvent{Type: ConnectionEventFailed, Attempt: attempt + 1, Err: lastErr})
+
+		if ctx.Err() != nil || attempt == policy.MaxRetries || !c.isRetryableError(lastErr, policy.RetryableErrors) {
+			break
+		}
+
+		select {
+		case <-time.After(backoffDelay(policy, attempt)):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return lastErr
+}
 
-	return &A2AClient{
-		config:       config,
-		httpClient:   httpClient,
-		wsDialer:     wsDialer,
-		messageQueue: make(map[string]chan *A2AResponse),
+// ConnectOnce makes a single attempt to establish connections to the A2A
+// service. With FallbackToHTTP a failed WebSocket dial still connects the
+// client, sending over HTTP until the socket is re-established.
+func (c *A2AClient) ConnectOnce(ctx context.Context) error {
+	err := c.connectOnce(ctx, c.config.FallbackToHTTP)
+	if err != nil {
+		c.failConnecting()
 	}
+	return err
+}
+
+// failConnecting returns the state to disconnected after Connect or
+// ConnectOnce gave up, unless the reconnect supervisor owns it
+func (c *A2AClient) failConnecting() {
+	c.setStateIf(ConnectionStateConnecting, ConnectionStateDisconnected)
+	c.deliverStateChanges()
 }
 
-// Connect establishes connections to the A2A service
-func (c *A2AClient) Connect(ctx context.Context) error {
+// connectOnce is ConnectOnce, falling back to HTTP on a failed dial only if
+// fallback is set
+func (c *A2AClient) connectOnce(ctx context.Context, fallback bool) error {
+	defer c.deliverStateChanges()
 	c.connectionMux.Lock()
 	defer c.connectionMux.Unlock()
 
+	c.setStateIf(ConnectionStateDisconnected, ConnectionStateConnecting)
+
+	lost := false
 	if c.config.WebSocketEnabled {
-		if err := c.connectWebSocket(ctx); err != nil {
-			return fmt.Errorf("failed to connect WebSocket: %w", err)
+		if err := c.connectWebSockets(ctx); err != nil {
+			if !fallback {
+				return fmt.Errorf("failed to connect WebSocket: %w", err)
+			}
+			c.log("WARN", "WebSocket unavailable, sending over HTTP", "error", err)
+			lost = true
 		}
 	}
 
+	// A reconnect supervisor is already running if the connection was lost
+	if lost && !c.connectionLost && c.config.ReconnectEnabled {
+		go c.reconnect()
+	}
+	if lost && (c.connectionLost || c.config.ReconnectEnabled) {
+		c.setState(ConnectionStateReconnecting)
+	} else {
+		c.setState(ConnectionStateConnected)
+	}
 	c.connected = true
+	c.connectionLost = lost
+
+	for _, link := range c.wsLinks {
+		if link != nil {
+			go c.restoreSubscriptions(link)
+			break
+		}
+	}
+
+	// Flush messages buffered while the connection was down
+	if c.reconnectQueue != nil {
+		go c.flushReconnectQueue()
+	}
+	if c.config.QueueWhenOffline {
+		go c.flushOfflineQueue()
+	}
+	return nil
+}
+
+// connectWebSockets dials every empty slot of the connection pool and starts
+// the new connections once all of them are open. If any dial fails, the
+// connections opened by this call are closed again. The caller must hold
+// connectionMux.
+func (c *A2AClient) connectWebSockets(ctx context.Context) error {
+	var opened []*wsLink
+	for i, existing := range c.wsLinks {
+		if existing != nil {
+			continue
+		}
+		link, err := c.connectWebSocket(ctx, i)
+		if err != nil {
+			for _, link := range opened {
+				c.wsLinks[link.index] = nil
+				link.conn.Close()
+			}
+			return err
+		}
+		c.wsLinks[i] = link
+		opened = append(opened, link)
+	}
+
+	for _, link := range opened {
+		c.startLink(link)
+	}
 	return nil
 }
 
-// connectWebSocket establishes WebSocket connection
-func (c *A2AClient) connectWebSocket(ctx context.Context) error {
-	wsURL := c.config.BaseURL
-	wsURL = "ws" + wsURL[4:] // Replace http/https with ws/wss
-	wsURL += "/ws"
+// startLink starts the keepalive and reader of a new connection and wakes
+// requests waiting to be replayed. The caller must hold connectionMux.
+func (c *A2AClient) startLink(link *wsLink) {
+	c.notifyLinksChanged()
+	c.startKeepAlive(link)
+	go c.handleWebSocketMessages(link)
+}
+
+// connectWebSocket dials a WebSocket connection for the given pool slot
+func (c *A2AClient) connectWebSocket(ctx context.Context, index int) (*wsLink, error) {
+	baseURL := c.endpoints.pick(nil)
+	wsURL := "ws" + strings.TrimPrefix(baseURL, "http") + "/ws" // http/https become ws/wss
+	if parsed, err := url.Parse(wsURL); err != nil || (parsed.Scheme != "ws" && parsed.Scheme != "wss") || parsed.Host == "" {
+		return nil, NewA2AClientError(CodeValidation, fmt.Sprintf("Invalid WebSocket URL %q for base URL %q", wsURL, baseURL), baseURL)
+	}
 
 	headers := http.Header{}
 	if c.config.APIKey != "" {
 		headers.Set("X-API-Key", c.config.APIKey)
 	}
 	headers.Set("User-Agent", "GeminiFlow-A2A-Go-SDK/2.0.0")
-
-	conn, _, err := c.wsDialer.DialContext(ctx, wsURL, headers)
+	token, err := c.authorize(headers)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	c.wsConn = conn
-
-	// Start message handler
-	go c.handleWebSocketMessages()
+	conn, resp, err := c.wsDialer.DialContext(ctx, wsURL, headers)
+	if err != nil && resp != nil && resp.StatusCode == http.StatusUnauthorized && token != nil {
+		if refreshed, ok := c.tokens.refresh(token); ok {
+			setBearer(headers, refreshed)
+			conn, resp, err = c.wsDialer.DialContext(ctx, wsURL, headers)
+		}
+	}
+	if err != nil {
+		if ctx.Err() == nil {
+			c.endpoints.recordFailure(baseURL)
+		}
+		return nil, dialError(ctx, resp, err)
+	}
+	c.endpoints.recordSuccess(baseURL)
 
-	return nil
+	return &wsLink{
+		index:         index,
+		conn:          conn,
+		done:          make(chan struct{}),
+		compressAbove: c.config.CompressionThreshold,
+	}, nil
 }
 
 // handleWebSocketMessages handles incoming WebSocket messages
-func (c *A2AClient) handleWebSocketMessages() {
-	defer func() {
-		if c.wsConn != nil {
-			c.wsConn.Close()
-		}
-	}()
+func (c *A2AClient) handleWebSocketMessages(link *wsLink) {
+	conn := link.conn
+	defer conn.Close()
+	defer close(link.done)
 
 	for {
-		_, message, err := c.wsConn.ReadMessage()
+		_, reader, err := conn.NextReader()
 		if err != nil {
+			c.handleConnectionLost(link, err)
 			break
 		}
+		c.extendReadDeadline(link)
 
-		var response A2AResponse
-		if err := json.Unmarshal(message, &response); err != nil {
+		message, err := readFrame(reader, c.config.MaxFrameBytes)
+		if errors.Is(err, errFrameTooLarge) {
+			c.rejectOversizedFrame(message)
 			continue
 		}
+		if err != nil {
+			c.handleConnectionLost(link, err)
+			break
+		}
 
-		c.queueMutex.RLock()
-		if ch, exists := c.messageQueue[response.CorrelationID]; exists {
-			select {
-			case ch <- &response:
-			default:
-			}
+		var response A2AResponse
+		if err := c.config.Codec.Unmarshal(message, &response); err != nil {
+			continue
 		}
-		c.queueMutex.RUnlock()
+
+		c.routeResponse(&response)
 	}
 }
 
-// Disconnect closes all connections
+// routeResponse delivers a response to the request waiting for it, or to
+// the topic's subscribers if no request is waiting
+func (c *A2AClient) routeResponse(response *A2AResponse) {
+	entry, exists := c.lookupPending(response)
+	if exists {
+		entry.deliver(response)
+	}
+
+	if !exists && response.Topic != "" {
+		c.publish(response)
+	}
+}
+
+// Disconnect closes all connections immediately, failing requests still in
+// flight over them. Use Shutdown to let them finish first.
 func (c *A2AClient) Disconnect() error {
+	defer c.deliverStateChanges()
 	c.connectionMux.Lock()
 	defer c.connectionMux.Unlock()
 
-	if c.wsConn != nil {
-		c.wsConn.Close()
-		c.wsConn = nil
+	for i, link := range c.wsLinks {
+		if link != nil {
+			link.conn.Close()
+			c.wsLinks[i] = nil
+		}
 	}
 
 	c.connected = false
+	c.connectionLost = false
+	c.setState(ConnectionStateDisconnected)
+	c.notifyLinksChanged()
+
+	if c.reconnectQueue != nil {
+		c.reconnectQueue.failAll(NewA2AClientError(CodeDisconnected, "Client disconnected before queued message was sent", nil))
+	}
 	return nil
 }
 
+// markConnectionLost records an unexpected drop of a pooled WebSocket
+// connection. ok is false for drops caused by Disconnect or of connections
+// already replaced; clientLost is true when no connection remains.
+func (c *A2AClient) markConnectionLost(link *wsLink) (clientLost, ok bool) {
+	c.connectionMux.Lock()
+	defer c.connectionMux.Unlock()
+
+	if !c.connected || c.wsLinks[link.index] != link {
+		return false, false
+	}
+	c.wsLinks[link.index] = nil
+	for _, other := range c.wsLinks {
+		if other != nil {
+			return false, true
+		}
+	}
+	c.connected = false
+	c.connectionLost = true
+	if c.config.ReconnectEnabled {
+		c.setState(ConnectionStateReconnecting)
+	} else {
+		c.setState(ConnectionStateDisconnected)
+	}
+	return true, true
+}
+
 // IsConnected returns connection status
 func (c *A2AClient) IsConnected() bool {
 	c.connectionMux.RLock()
@@ -611,140 +1365,488 @@ func (c *A2AClient) IsConnected() bool {
 	return c.connected
 }
 
-// SendMessage sends an A2A message with retry policy
-func (c *A2AClient) SendMessage(ctx context.Context, message *A2AMessage) (*A2AResponse, error) {
+// Reset clears accumulated runtime state while keeping the configuration
+// and any open connection: cached responses, the circuit breaker, endpoint
+// health, the batch tool breakers, rate limiter tokens and the cached OAuth2
+// token are reset, and messages buffered for reconnection or stored while
+// offline are dropped. WebSocket requests waiting for a response fail with
+// CLIENT_RESET, as do the dropped messages; streams, subscriptions and HTTP
+// requests already in flight are not affected.
+func (c *A2AClient) Reset() {
+	resetErr := NewA2AClientError(CodeClientReset, "Client was reset before queued message was sent", nil)
+
+	c.InvalidateCache()
+	if c.circuitBreaker != nil {
+		c.circuitBreaker.reset()
+	}
+	c.endpoints.reset()
+	c.toolBreakers.reset()
+	if bucket, ok := c.rateLimiter.(*tokenBucket); ok {
+		bucket.reset()
+	}
+	if c.tokens != nil {
+		c.tokens.reset()
+	}
+	if c.reconnectQueue != nil {
+		c.reconnectQueue.failAll(resetErr)
+	}
+	if c.config.QueueWhenOffline {
+		c.dropOfflineQueue(resetErr)
+	}
+	c.failPending(NewA2AClientError(CodeClientReset, "Client was reset while waiting for a response", nil))
+}
+
+// SendMessage sends an A2A message with retry policy, through the configured
+// Interceptors
+func (c *A2AClient) SendMessage(ctx context.Context, message *A2AMessage) (response *A2AResponse, err error) {
+	c.prepareMessage(message)
+	stampCreated(message)
+	applyDeadline(ctx, message)
+	c.observeRequest(message)
+	defer c.observeResult(message, time.Now(), &response, &err)
+
+	ctx, endSpan := c.startSpan(ctx, message)
+	defer func() { endSpan(response, err) }()
+
+	ctx, end, err := c.beginRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer end()
+	defer func() { err = c.shutdownError(err) }()
+	ctx = withEndpointAttempts(ctx)
+
+	return c.send(ctx, message)
+}
+
+// sendMessage is the innermost step of SendMessage, wrapped by the
+// configured interceptors
+func (c *A2AClient) sendMessage(ctx context.Context, message *A2AMessage) (response *A2AResponse, err error) {
+	if err := validateRetryPolicy(message); err != nil {
+		return nil, err
+	}
+
+	// Serve repeated reads from the response cache, and drop cached reads
+	// of any namespace this message writes to once it has been sent
+	cacheKey, cacheable := c.responseCacheKey(message)
+	if cacheable && !cacheBypassed(ctx) {
+		if cached, ok := c.cachedResponse(cacheKey); ok {
+			return cached, nil
+		}
+	}
+	defer c.invalidateWrites(message)
+
+	response, err = c.deliver(ctx, message)
+	if err == nil && cacheable {
+		c.cacheResponse(cacheKey, response)
+	}
+	return response, err
+}
+
+// deliver sends the message, or holds it back while the WebSocket is
+// reconnecting or the client is offline
+func (c *A2AClient) deliver(ctx context.Context, message *A2AMessage) (*A2AResponse, error) {
+	if queueingDisabled(ctx) {
+		return c.transmit(ctx, message)
+	}
+
+	// Buffer the message while the connection is being re-established
+	entry, buffered, err := c.enqueueIfReconnecting(ctx, message)
+	if err != nil {
+		return nil, err
+	}
+	if buffered {
+		return c.awaitQueuedMessage(ctx, entry)
+	}
+
+	// Store the message for later if the client is offline
+	if queued, err := c.enqueueIfOffline(message); queued {
+		if err != nil {
+			return nil, err
+		}
+		return nil, ErrQueued
+	}
+
+	return c.transmit(ctx, message)
+}
+
+// transmit sends a message under the retry policy. It is the last step of
+// SendMessage and of flushing buffered messages.
+func (c *A2AClient) transmit(ctx context.Context, message *A2AMessage) (*A2AResponse, error) {
+	return c.executeWithRetry(ctx, message, func(ctx context.Context) (*A2AResponse, error) {
+		return c.doSendMessage(ctx, message)
+	})
+}
+
+// applyDeadline sets the message's execution timeout from ctx's deadline,
+// unless a timeout is already set, so the server stops working on it when
+// the caller stops waiting. The remaining time is rounded down to whole
+// seconds, with a minimum of one.
+func applyDeadline(ctx context.Context, message *A2AMessage) {
+	deadline, ok := ctx.Deadline()
+	if !ok || (message.Execution != nil && message.Execution.Timeout != nil) {
+		return
+	}
+	remaining := time.Until(deadline)
+	if remaining <= 0 {
+		return
+	}
+	seconds := int(remaining / time.Second)
+	if seconds < 1 {
+		seconds = 1
+	}
+	if message.Execution == nil {
+		message.Execution = &ExecutionContext{}
+	}
+	message.Execution.Timeout = intPtr(seconds)
+}
+
+// prepareMessage fills in the fields the client sets on every outgoing
+// message
+func (c *A2AClient) prepareMessage(message *A2AMessage) {
 	// Generate message ID if not provided
 	if message.ID == "" {
 		message.ID = c.generateMessageID()
 	}
+	if message.IdempotencyKey == "" {
+		message.IdempotencyKey = message.ID
+	}
 
 	// Add timestamp
 	now := time.Now().Unix()
 	message.Timestamp = &now
 
-	// Execute with retry
-	return c.executeWithRetry(ctx, func() (*A2AResponse, error) {
-		return c.doSendMessage(ctx, message)
-	})
+	c.applyInheritedEnv(message)
 }
 
 // doSendMessage performs the actual message sending
 func (c *A2AClient) doSendMessage(ctx context.Context, message *A2AMessage) (*A2AResponse, error) {
-	if c.wsConn != nil {
-		return c.sendViaWebSocket(ctx, message)
+	if err := c.dispatch.acquire(ctx, message.Priority); err != nil {
+		return nil, err
+	}
+	defer c.dispatch.release()
+	if err := c.waitRateLimit(ctx); err != nil {
+		return nil, err
 	}
-	return c.sendViaHTTP(ctx, message)
+	if c.circuitBreaker != nil && !c.circuitBreaker.allow() {
+		return nil, NewA2AClientError(CodeCircuitOpen, "Circuit breaker is open after repeated transport failures", nil)
+	}
+
+	c.logRequest(message)
+	start := time.Now()
+
+	response, err := c.transport.Send(ctx, message)
+
+	c.logResponse(message, response, err, time.Since(start))
+	if c.circuitBreaker != nil {
+		if ctx.Err() != nil {
+			// Abandoned by the caller; says nothing about the server
+			c.circuitBreaker.release()
+		} else {
+			c.circuitBreaker.record(err != nil)
+		}
+	}
+	return response, err
 }
 
 // sendViaWebSocket sends message via WebSocket
-func (c *A2AClient) sendViaWebSocket(ctx context.Context, message *A2AMessage) (*A2AResponse, error) {
+func (c *A2AClient) sendViaWebSocket(ctx context.Context, link *wsLink, message *A2AMessage) (*A2AResponse, error) {
+	// Responses are routed by message ID, or by correlation ID for servers
+	// that only echo that; it defaults to the message ID
+	if message.CorrelationID == "" {
+		message.CorrelationID = message.ID
+	}
+
+	timeout := c.config.Timeout
+	if message.Execution != nil && message.Execution.Timeout != nil {
+		timeout = time.Duration(*message.Execution.Timeout) * time.Second
+	}
+
 	// Create response channel
 	responseChan := make(chan *A2AResponse, 1)
-	c.queueMutex.Lock()
-	c.messageQueue[message.ID] = responseChan
-	c.queueMutex.Unlock()
+	unregister, err := c.registerPending(message.ID, message.CorrelationID, responseChan, time.Now().Add(timeout+pendingGrace))
+	if err != nil {
+		return nil, err
+	}
+	defer unregister()
 
-	defer func() {
-		c.queueMutex.Lock()
-		delete(c.messageQueue, message.ID)
-		c.queueMutex.Unlock()
-	}()
+	if traceContext := c.injectTraceContext(ctx); traceContext != nil {
+		message.TraceContext = traceContext
+	}
+	message.RequestID = requestID(ctx, message)
 
-	// Send message
-	messageBytes, err := json.Marshal(message)
+	// Send message. The frame is written again on replay, so the buffer is
+	// kept until the response arrives.
+	buf, err := c.encodeMessage(message)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal message: %w", err)
 	}
+	defer releaseBuffer(buf)
+	messageBytes := buf.Bytes()
 
-	if err := c.wsConn.WriteMessage(websocket.TextMessage, messageBytes); err != nil {
-		return nil, fmt.Errorf("failed to send WebSocket message: %w", err)
-	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
 
-	// Wait for response
-	timeout := c.config.Timeout
-	if message.Execution != nil && message.Execution.Timeout != nil {
-		timeout = time.Duration(*message.Execution.Timeout) * time.Second
-	}
+	for {
+		if err := link.write(c.frameType(), messageBytes); err != nil {
+			return nil, transportError(ctx, "send WebSocket message", err)
+		}
 
-	select {
-	case response := <-responseChan:
+		// Wait for response
+		var response *A2AResponse
+		select {
+		case response = <-responseChan:
+		case <-link.done:
+			select {
+			case response = <-responseChan:
+				// Arrived just before the connection dropped
+			default:
+				next, err := c.replayLink(ctx, message, timer.C)
+				if err != nil {
+					return nil, err
+				}
+				link = next
+				continue
+			}
+		case <-timer.C:
+			return nil, NewA2AClientError(CodeTimeout, "WebSocket message timeout", nil)
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if response == nil {
+			// Closed by the pending request janitor
+			return nil, NewA2AClientError(CodeTimeout, "WebSocket message timeout", nil)
+		}
+
+		if response.Error != nil && (response.Error.Code == CodeFrameTooLarge || response.Error.Code == CodeClientReset) {
+			return nil, NewA2AClientError(response.Error.Code, response.Error.Message, response.Error.Details)
+		}
 		return response, nil
-	case <-time.After(timeout):
-		return nil, NewA2AClientError("A2A_TIMEOUT_ERROR", "WebSocket message timeout", nil)
-	case <-ctx.Done():
-		return nil, ctx.Err()
 	}
 }
 
 // sendViaHTTP sends message via HTTP
 func (c *A2AClient) sendViaHTTP(ctx context.Context, message *A2AMessage) (*A2AResponse, error) {
-	messageBytes, err := json.Marshal(message)
+	resp, err := c.postMessage(ctx, message)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Read at most one byte past the limit to tell an oversized body from
+	// one that fits exactly
+	body := &io.LimitedReader{R: resp.Body, N: c.config.MaxResponseBytes + 1}
+	var response A2AResponse
+	err = c.decodeBody(ctx, body, &response)
+	if body.N <= 0 {
+		return nil, NewA2AClientError(CodeResponseTooLarge,
+			fmt.Sprintf("Response exceeds the %d byte limit", c.config.MaxResponseBytes), c.config.MaxResponseBytes)
+	}
+	if err != nil {
+		return nil, err
+	}
+	// Read the trailing newline or so up to EOF, letting the connection be
+	// reused
+	io.Copy(io.Discard, io.LimitReader(resp.Body, errorBodyPrefix))
+
+	return &response, nil
+}
+
+// errorBodyPrefix is how much of an error response's body is quoted in the
+// returned error
+const errorBodyPrefix = 512
+
+// postMessage posts a message to the HTTP endpoint and returns the response
+// once its status has been checked. The caller must close the body.
+func (c *A2AClient) postMessage(ctx context.Context, message *A2AMessage) (*http.Response, error) {
+	return c.post(ctx, "/api/v2/a2a/message", c.config.Codec.ContentType(), message)
+}
+
+// post posts a message to the given API path, asking for the accept media
+// type, and checks the response status. The caller must close the body.
+func (c *A2AClient) post(ctx context.Context, path, accept string, message *A2AMessage) (*http.Response, error) {
+	buf, err := c.encodeMessage(message)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal message: %w", err)
 	}
+	body, contentEncoding, err := c.requestBody(buf.Bytes())
+	if err != nil {
+		releaseBuffer(buf)
+		return nil, fmt.Errorf("failed to compress message: %w", err)
+	}
 
-	req, err := http.NewRequestWithContext(ctx, "POST", c.config.BaseURL+"/api/v2/a2a/message", bytes.NewReader(messageBytes))
+	baseURL := c.endpoints.pick(endpointAttemptsFrom(ctx))
+	req, err := http.NewRequestWithContext(ctx, "POST", baseURL+path, nil)
 	if err != nil {
+		releaseBuffer(buf)
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
+	if contentEncoding != "" {
+		// Compressed into a new slice; the pooled one is no longer needed
+		releaseBuffer(buf)
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+	} else {
+		pooled := newPooledBody(buf)
+		defer pooled.release()
+		req.Body = pooled.reader()
+		req.GetBody = func() (io.ReadCloser, error) {
+			return pooled.reader(), nil
+		}
+	}
+	req.ContentLength = int64(len(body))
+	if contentEncoding != "" {
+		req.Header.Set("Content-Encoding", contentEncoding)
+	}
 
-	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Type", c.config.Codec.ContentType())
+	req.Header.Set("Accept", accept)
 	req.Header.Set("User-Agent", "GeminiFlow-A2A-Go-SDK/2.0.0")
 	if c.config.APIKey != "" {
 		req.Header.Set("X-API-Key", c.config.APIKey)
 	}
-
-	resp, err := c.httpClient.Do(req)
+	token, err := c.authorize(req.Header)
 	if err != nil {
-		return nil, fmt.Errorf("failed to send HTTP request: %w", err)
+		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("HTTP request failed with status %d", resp.StatusCode)
+	if len(message.Projection) > 0 {
+		req.Header.Set("X-A2A-Fields", strings.Join(message.Projection, ","))
+	}
+	if message.IdempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", message.IdempotencyKey)
+	}
+	if id := requestID(ctx, message); id != "" {
+		req.Header.Set("X-Request-ID", id)
+	}
+	for key, value := range c.injectTraceContext(ctx) {
+		req.Header.Set(key, value)
 	}
 
-	responseBytes, err := io.ReadAll(resp.Body)
+	resp, err := c.httpClient.Do(req)
+	if err == nil && resp.StatusCode == http.StatusUnauthorized && token != nil {
+		// The token may have been revoked or expired early; try one new one
+		if refreshed, ok := c.tokens.refresh(token); ok {
+			resp.Body.Close()
+			retry := req.Clone(ctx)
+			retry.Body, _ = req.GetBody()
+			setBearer(retry.Header, refreshed)
+			resp, err = c.httpClient.Do(retry)
+		}
+	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		if ctx.Err() == nil {
+			c.endpointFailed(ctx, baseURL)
+		}
+		return nil, transportError(ctx, "send HTTP request", err)
+	}
+	if resp.StatusCode >= http.StatusInternalServerError {
+		// Counted against the endpoint like a transport failure, so that
+		// retries fail over to the next one
+		prefix, _ := io.ReadAll(io.LimitReader(resp.Body, errorBodyPrefix))
+		resp.Body.Close()
+		c.endpointFailed(ctx, baseURL)
+		message := fmt.Sprintf("HTTP request failed with status %d", resp.StatusCode)
+		if text := strings.TrimSpace(string(prefix)); text != "" {
+			message += ": " + text
+		}
+		return nil, NewA2AClientError(CodeServerError, message, resp.StatusCode)
 	}
+	c.endpoints.recordSuccess(baseURL)
 
-	var response A2AResponse
-	if err := json.Unmarshal(responseBytes, &response); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	if resp.StatusCode == http.StatusTooManyRequests {
+		resp.Body.Close()
+		var retryAfter interface{}
+		if delay, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			retryAfter = delay
+		}
+		return nil, NewA2AClientError(CodeRateLimited, "Server rate limit exceeded", retryAfter)
+	}
+	if resp.StatusCode != http.StatusOK {
+		// Only the start of the body is read; an error page can be large
+		prefix, _ := io.ReadAll(io.LimitReader(resp.Body, errorBodyPrefix))
+		resp.Body.Close()
+		if text := strings.TrimSpace(string(prefix)); text != "" {
+			return nil, fmt.Errorf("HTTP request failed with status %d: %s", resp.StatusCode, text)
+		}
+		return nil, fmt.Errorf("HTTP request failed with status %d", resp.StatusCode)
 	}
 
-	return &response, nil
+	return resp, nil
 }
 
-// executeWithRetry executes operation with retry policy
-func (c *A2AClient) executeWithRetry(ctx context.Context, operation func() (*A2AResponse, error)) (*A2AResponse, error) {
-	policy := c.config.RetryPolicy
+// executeWithRetry executes operation with retry policy. Every attempt
+// resends message unchanged apart from trace context, so its ID and
+// IdempotencyKey must stay constant here; that is what lets the server
+// discard an attempt it has already executed. No attempt is made once the
+// message's TTL has run out; MESSAGE_EXPIRED is returned instead. The
+// message's own RetryPolicy, if set, overrides the client's.
+func (c *A2AClient) executeWithRetry(ctx context.Context, message *A2AMessage, operation func(context.Context) (*A2AResponse, error)) (_ *A2AResponse, err error) {
+	defer func() {
+		if err != nil && ctx.Err() == nil {
+			c.deadLetter(message, err)
+		}
+	}()
+
+	policy := c.retryPolicy(message)
+	if retriesDisabled(ctx) {
+		single := *policy
+		single.MaxRetries = 0
+		policy = &single
+	}
 	var lastErr error
 
+	attemptCtx := ctx
+	var budgetEnd time.Time
+	if policy.TotalTimeout > 0 {
+		budgetEnd = time.Now().Add(policy.TotalTimeout)
+		var cancel context.CancelFunc
+		attemptCtx, cancel = context.WithDeadline(ctx, budgetEnd)
+		defer cancel()
+	}
+	budgetExceeded := func(err error) error {
+		return wrapError(CodeRetryBudgetExceeded,
+			fmt.Sprintf("Retry budget of %v exhausted: %v", policy.TotalTimeout, err), err)
+	}
+
 	for attempt := 0; attempt <= policy.MaxRetries; attempt++ {
-		response, err := operation()
+		if err := checkExpired(message); err != nil {
+			return nil, err
+		}
+
+		response, err := operation(attemptCtx)
 		if err == nil {
 			return response, nil
 		}
 
 		lastErr = err
+		if ctx.Err() == nil && attemptCtx.Err() != nil {
+			return nil, budgetExceeded(err)
+		}
 
 		// Check if error is retryable
 		if !c.isRetryableError(err, policy.RetryableErrors) || attempt == policy.MaxRetries {
 			break
 		}
 
-		// Calculate delay
-		var delay time.Duration
-		if policy.BackoffStrategy == "exponential" {
-			delay = time.Duration(math.Min(float64(policy.BaseDelay)*math.Pow(2, float64(attempt)), float64(policy.MaxDelay)))
-		} else {
-			delay = time.Duration(math.Min(float64(policy.BaseDelay)*float64(attempt+1), float64(policy.MaxDelay)))
+		delay := jitterDelay(policy, backoffDelay(policy, attempt))
+		if retryAfter, ok := retryAfterDelay(err); ok {
+			delay = retryAfter
+			if policy.MaxDelay > 0 && delay > policy.MaxDelay {
+				delay = policy.MaxDelay
+			}
+		}
+		if !budgetEnd.IsZero() && time.Now().Add(delay).After(budgetEnd) {
+			return nil, budgetExceeded(err)
 		}
 
+		c.log("INFO", "retrying message", "id", message.ID, "tool", message.ToolName,
+			"attempt", attempt+1, "delay", delay, "error", err)
 		select {
 		case <-time.After(delay):
+			c.observeRetry(message, attempt+1)
+			recordRetry(ctx, attempt+1)
 			continue
 		case <-ctx.Done():
 			return nil, ctx.Err()
@@ -754,13 +1856,102 @@ func (c *A2AClient) executeWithRetry(ctx context.Context, operation func() (*A2A
 	return nil, lastErr
 }
 
-// isRetryableError checks if error is retryable
+type noRetryKey struct{}
+
+// withoutRetries returns a context whose requests are attempted only once,
+// for operations that must not run twice
+func withoutRetries(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noRetryKey{}, true)
+}
+
+// retriesDisabled reports whether ctx came from withoutRetries
+func retriesDisabled(ctx context.Context) bool {
+	disabled, _ := ctx.Value(noRetryKey{}).(bool)
+	return disabled
+}
+
+// deadLetter passes a message the client has given up on to the
+// OnDeadLetter callback, if set
+func (c *A2AClient) deadLetter(message *A2AMessage, lastErr error) {
+	if c.config.OnDeadLetter != nil {
+		c.config.OnDeadLetter(message, lastErr)
+	}
+}
+
+// jitterDelay applies the policy's jitter to a computed backoff delay
+func jitterDelay(policy *RetryPolicy, delay time.Duration) time.Duration {
+	random := policy.JitterSource
+	if random == nil {
+		random = rand.Float64
+	}
+	switch policy.Jitter {
+	case "full":
+		return time.Duration(random() * float64(delay))
+	case "equal":
+		return delay/2 + time.Duration(random()*float64(delay/2))
+	default:
+		return delay
+	}
+}
+
+// backoffDelay calculates the delay before the retry following the given
+// zero-based attempt
+func backoffDelay(policy *RetryPolicy, attempt int) time.Duration {
+	if policy.BackoffStrategy == "custom" && policy.CustomBackoff != nil {
+		delay := policy.CustomBackoff(attempt, policy.BaseDelay, policy.MaxDelay)
+		if delay <= 0 {
+			return 0
+		}
+		if policy.MaxDelay > 0 && delay > policy.MaxDelay {
+			return policy.MaxDelay
+		}
+		return delay
+	}
+	if policy.BackoffStrategy == "exponential" {
+		return time.Duration(math.Min(float64(policy.BaseDelay)*math.Pow(2, float64(attempt)), float64(policy.MaxDelay)))
+	}
+	return time.Duration(math.Min(float64(policy.BaseDelay)*float64(attempt+1), float64(policy.MaxDelay)))
+}
+
+// parseRetryAfter parses a Retry-After header in either delta-seconds or
+// HTTP-date form. Dates in the past yield zero.
+func parseRetryAfter(header string) (time.Duration, bool) {
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		if delay := time.Until(date); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// retryAfterDelay returns the server-requested wait carried by a RATE_LIMITED
+// error
+func retryAfterDelay(err error) (time.Duration, bool) {
+	var clientErr *A2AClientError
+	if !errors.As(err, &clientErr) || clientErr.Code != CodeRateLimited {
+		return 0, false
+	}
+	delay, ok := clientErr.Details.(time.Duration)
+	return delay, ok
+}
+
+// isRetryableError checks if error is, or wraps, a client error with one
+// of the retryable codes
 func (c *A2AClient) isRetryableError(err error, retryableErrors []string) bool {
-	if clientErr, ok := err.(*A2AClientError); ok {
-		for _, retryableErr := range retryableErrors {
-			if clientErr.Code == retryableErr {
-				return true
-			}
+	for _, retryableErr := range retryableErrors {
+		if HasCode(err, retryableErr) {
+			return true
 		}
 	}
 	return false
@@ -807,9 +1998,9 @@ func (c *A2AClient) InitializeSwarm(ctx context.Context, config SwarmConfig) (*A
 		},
 		ToolName: toolName,
 		Parameters: map[string]interface{}{
-			"topology":   config.Topology,
-			"maxAgents":  config.MaxAgents,
-			"strategy":   config.Strategy,
+			"topology":  config.Topology,
+			"maxAgents": config.MaxAgents,
+			"strategy":  config.Strategy,
 		},
 		Coordination: coordination,
 	}
@@ -854,6 +2045,9 @@ func (c *A2AClient) SpawnAgent(ctx context.Context, config AgentSpawnConfig) (*A
 			},
 		},
 	}
+	if config.SwarmID != "" {
+		message.Parameters["swarmId"] = config.SwarmID
+	}
 
 	return c.SendMessage(ctx, message)
 }
@@ -864,6 +2058,7 @@ type AgentSpawnConfig struct {
 	Name              string
 	Capabilities      []string
 	PlacementStrategy string // "load-balanced", "capability-matched", "geographic"
+	SwarmID           string // optional swarm to join
 }
 
 // OrchestrateTasks orchestrates a complex task
@@ -919,9 +2114,48 @@ type TaskOrchestrationConfig struct {
 	Stages    []PipelineStage
 }
 
+// memoryConsistencyLevels lists the accepted memory consistency values
+var memoryConsistencyLevels = []string{"eventual", "strong", "causal"}
+
+// resolveConsistency returns the consistency to use for a memory operation:
+// the explicit value if set, else the namespace default, else the global
+// default
+func (c *A2AClient) resolveConsistency(namespace, consistency string) (string, error) {
+	source := "Consistency"
+	if consistency == "" {
+		if nsConsistency, ok := c.config.NamespaceConsistency[namespace]; ok {
+			consistency = nsConsistency
+			source = fmt.Sprintf("NamespaceConsistency[%q]", namespace)
+		} else if c.config.DefaultConsistency != "" {
+			consistency = c.config.DefaultConsistency
+			source = "DefaultConsistency"
+		} else {
+			return "eventual", nil
+		}
+	}
+
+	for _, level := range memoryConsistencyLevels {
+		if consistency == level {
+			return consistency, nil
+		}
+	}
+	return "", NewA2AClientError(CodeValidation,
+		fmt.Sprintf("%s %q must be one of %v", source, consistency, memoryConsistencyLevels), consistency)
+}
+
 // StoreMemory stores data in distributed memory
 func (c *A2AClient) StoreMemory(ctx context.Context, config MemoryStoreConfig) (*A2AResponse, error) {
-	message := &A2AMessage{
+	consistency, err := c.resolveConsistency(config.Namespace, config.Consistency)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.SendMessage(ctx, storeMemoryMessage(config, consistency))
+}
+
+// storeMemoryMessage builds the memory_usage message storing one key
+func storeMemoryMessage(config MemoryStoreConfig, consistency string) *A2AMessage {
+	return &A2AMessage{
 		Target: AgentTarget{
 			GroupTarget: &GroupTarget{
 				Type:      "group",
@@ -949,12 +2183,10 @@ func (c *A2AClient) StoreMemory(ctx context.Context, config MemoryStoreConfig) (
 				Type:        "write",
 				Namespace:   config.Namespace,
 				Keys:        []string{config.Key},
-				Consistency: config.Consistency,
+				Consistency: consistency,
 			},
 		},
 	}
-
-	return c.SendMessage(ctx, message)
 }
 
 // MemoryStoreConfig represents memory store configuration
@@ -963,16 +2195,28 @@ type MemoryStoreConfig struct {
 	Value             interface{}
 	Namespace         string
 	TTL               *int
-	Consistency       string // "eventual", "strong", "causal"
+	Consistency       string // "eventual", "strong", "causal"; empty uses the namespace default
 	ReplicationFactor int
 }
 
 // RetrieveMemory retrieves data from distributed memory
 func (c *A2AClient) RetrieveMemory(ctx context.Context, config MemoryRetrieveConfig) (*A2AResponse, error) {
+	consistency, err := c.resolveConsistency(config.Namespace, config.Consistency)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.SendMessage(ctx, retrieveMemoryMessage(config.Namespace, []string{config.Key}, consistency))
+}
+
+// retrieveMemoryMessage builds the memory_usage message reading keys from a
+// namespace. A single key is sent as "key" with the "retrieve" action, more
+// as "keys" with "retrieve_batch".
+func retrieveMemoryMessage(namespace string, keys []string, consistency string) *A2AMessage {
 	maxAgents := 1
 	var coordination CoordinationMode
 
-	if config.Consistency == "strong" {
+	if consistency == "strong" {
 		maxAgents = 3
 		coordination = CoordinationMode{
 			ConsensusCoordination: &ConsensusCoordination{
@@ -988,7 +2232,18 @@ func (c *A2AClient) RetrieveMemory(ctx context.Context, config MemoryRetrieveCon
 		}
 	}
 
-	message := &A2AMessage{
+	params := map[string]interface{}{
+		"action":    "retrieve",
+		"namespace": namespace,
+	}
+	if len(keys) == 1 {
+		params["key"] = keys[0]
+	} else {
+		params["action"] = "retrieve_batch"
+		params["keys"] = keys
+	}
+
+	return &A2AMessage{
 		Target: AgentTarget{
 			GroupTarget: &GroupTarget{
 				Type:      "group",
@@ -996,31 +2251,25 @@ func (c *A2AClient) RetrieveMemory(ctx context.Context, config MemoryRetrieveCon
 				MaxAgents: intPtr(maxAgents),
 			},
 		},
-		ToolName: MCPToolClaudeFlowMemoryUsage,
-		Parameters: map[string]interface{}{
-			"action":    "retrieve",
-			"key":       config.Key,
-			"namespace": config.Namespace,
-		},
+		ToolName:     MCPToolClaudeFlowMemoryUsage,
+		Parameters:   params,
 		Coordination: coordination,
 		StateRequirements: []StateRequirement{
 			{
 				Type:        "read",
-				Namespace:   config.Namespace,
-				Keys:        []string{config.Key},
-				Consistency: config.Consistency,
+				Namespace:   namespace,
+				Keys:        keys,
+				Consistency: consistency,
 			},
 		},
 	}
-
-	return c.SendMessage(ctx, message)
 }
 
 // MemoryRetrieveConfig represents memory retrieve configuration
 type MemoryRetrieveConfig struct {
 	Key         string
 	Namespace   string
-	Consistency string // "eventual", "strong", "causal"
+	Consistency string // "eventual", "strong", "causal"; empty uses the namespace default
 }
 
 // GetSwarmStatus gets swarm status
@@ -1052,12 +2301,18 @@ func (c *A2AClient) GetSwarmStatus(ctx context.Context, swarmID string) (*A2ARes
 
 // ListAgents lists all agents
 func (c *A2AClient) ListAgents(ctx context.Context, filter *AgentFilter) (*A2AResponse, error) {
+	return c.SendMessage(ctx, listAgentsMessage(filter))
+}
+
+// listAgentsMessage builds an agent_list message broadcast to agents
+// matching filter
+func listAgentsMessage(filter *AgentFilter) *A2AMessage {
 	params := make(map[string]interface{})
 	if filter != nil {
 		params["filter"] = filter
 	}
 
-	message := &A2AMessage{
+	return &A2AMessage{
 		Target: AgentTarget{
 			BroadcastTarget: &BroadcastTarget{
 				Type:   "broadcast",
@@ -1073,8 +2328,22 @@ func (c *A2AClient) ListAgents(ctx context.Context, filter *AgentFilter) (*A2ARe
 			},
 		},
 	}
+}
 
-	return c.SendMessage(ctx, message)
+// decodeAgents decodes an agent_list result, accepting either a bare list of
+// agents or an object with an "agents" field
+func decodeAgents(result interface{}) ([]AgentIdentifier, error) {
+	if wrapper, ok := result.(map[string]interface{}); ok {
+		if inner, ok := wrapper["agents"]; ok {
+			result = inner
+		}
+	}
+
+	var agents []AgentIdentifier
+	if err := decodeMap(result, &agents); err != nil {
+		return nil, fmt.Errorf("failed to decode agent list: %w", err)
+	}
+	return agents, nil
 }
 
 // A2AUtils provides utility functions for A2A operations
@@ -1130,6 +2399,26 @@ func (A2AUtils) BroadcastTarget(filter *AgentFilter) AgentTarget {
 	}
 }
 
+// ConditionalTarget creates a conditional target with an optional fallback
+func (A2AUtils) ConditionalTarget(conditions []AgentCondition, fallback *AgentTarget) AgentTarget {
+	return AgentTarget{
+		ConditionalTarget: &ConditionalTarget{
+			Type:       "conditional",
+			Conditions: conditions,
+			Fallback:   fallback,
+		},
+	}
+}
+
+// Condition creates an agent condition
+func (A2AUtils) Condition(condType, operator string, value interface{}) AgentCondition {
+	return AgentCondition{
+		Type:     condType,
+		Operator: operator,
+		Value:    value,
+	}
+}
+
 // DirectCoordination creates direct coordination
 func (A2AUtils) DirectCoordination(timeout, retries *int, acknowledgment bool) CoordinationMode {
 	return CoordinationMode{
@@ -1187,6 +2476,10 @@ func (A2AUtils) PipelineCoordination(stages []PipelineStage, failureStrategy str
 	}
 }
 
+// maxConsensusParticipants bounds MinimumParticipants; no swarm comes close
+// to it, so a larger value is a mistake rather than a real requirement
+const maxConsensusParticipants = 1000
+
 // ValidateMessage validates an A2A message
 func (A2AUtils) ValidateMessage(message *A2AMessage) []string {
 	var errors []string
@@ -1206,6 +2499,14 @@ func (A2AUtils) ValidateMessage(message *A2AMessage) []string {
 		errors = append(errors, "Coordination mode is required")
 	}
 
+	if message.Execution != nil {
+		for key := range message.Execution.Environment {
+			if err := ValidateEnvName(key); err != nil {
+				errors = append(errors, fmt.Sprintf("Execution environment has %v", err))
+			}
+		}
+	}
+
 	// Validate target-specific requirements
 	if message.Target.MultipleTargets != nil && len(message.Target.MultipleTargets.AgentIDs) == 0 {
 		errors = append(errors, "Multiple target requires at least one agent ID")
@@ -1215,11 +2516,112 @@ func (A2AUtils) ValidateMessage(message *A2AMessage) []string {
 		errors = append(errors, "Group target requires a role")
 	}
 
+	if message.Target.GroupTarget != nil {
+		for _, capability := range message.Target.GroupTarget.Capabilities {
+			if _, err := ParseCapabilityConstraint(capability); err != nil {
+				errors = append(errors, fmt.Sprintf("Group target has %v", err))
+			}
+		}
+	}
+
+	if message.Target.ConditionalTarget != nil {
+		for i, condition := range message.Target.ConditionalTarget.Conditions {
+			if condition.Type == "" {
+				errors = append(errors, fmt.Sprintf("Conditional target condition %d requires a type", i))
+			}
+			if condition.Operator == "" {
+				errors = append(errors, fmt.Sprintf("Conditional target condition %d requires an operator", i))
+			}
+		}
+	}
+
+	if message.RetryPolicy != nil {
+		errors = append(errors, retryPolicyProblems(message.RetryPolicy)...)
+	}
+
 	// Validate coordination-specific requirements
 	if message.Coordination.PipelineCoordination != nil && len(message.Coordination.PipelineCoordination.Stages) == 0 {
 		errors = append(errors, "Pipeline coordination requires at least one stage")
 	}
 
+	if direct := message.Coordination.DirectCoordination; direct != nil {
+		if direct.Timeout != nil && *direct.Timeout <= 0 {
+			errors = append(errors, "Direct coordination timeout must be positive")
+		}
+		if direct.Retries != nil && *direct.Retries < 0 {
+			errors = append(errors, "Direct coordination retries must not be negative")
+		}
+	}
+
+	if broadcast := message.Coordination.BroadcastCoordination; broadcast != nil {
+		switch broadcast.Aggregation {
+		case "all", "majority", "first", "any":
+		default:
+			errors = append(errors, fmt.Sprintf("Broadcast aggregation must be all, majority, first or any, got %q", broadcast.Aggregation))
+		}
+		if broadcast.Timeout != nil && *broadcast.Timeout <= 0 {
+			errors = append(errors, "Broadcast coordination timeout must be positive")
+		}
+	}
+
+	if consensus := message.Coordination.ConsensusCoordination; consensus != nil {
+		switch consensus.ConsensusType {
+		case "unanimous", "majority", "weighted":
+		default:
+			errors = append(errors, fmt.Sprintf("Consensus type must be unanimous, majority or weighted, got %q", consensus.ConsensusType))
+		}
+		if consensus.VotingTimeout != nil && *consensus.VotingTimeout <= 0 {
+			errors = append(errors, "Consensus voting timeout must be positive")
+		}
+		if consensus.MinimumParticipants != nil {
+			if *consensus.MinimumParticipants <= 0 {
+				errors = append(errors, "Consensus minimum participants must be positive")
+			} else if *consensus.MinimumParticipants > maxConsensusParticipants {
+				errors = append(errors, fmt.Sprintf("Consensus minimum participants must be at most %d, got %d",
+					maxConsensusParticipants, *consensus.MinimumParticipants))
+			}
+		}
+	}
+
+	if pipeline := message.Coordination.PipelineCoordination; pipeline != nil {
+		for i, stage := range pipeline.Stages {
+			if stage.Timeout != nil && *stage.Timeout <= 0 {
+				errors = append(errors, fmt.Sprintf("Pipeline stage %d timeout must be positive", i))
+			}
+		}
+	}
+
+	for i, requirement := range message.ResourceRequirements {
+		switch requirement.Type {
+		case "cpu", "memory", "gpu", "network", "storage", "custom":
+		default:
+			errors = append(errors, fmt.Sprintf("Resource requirement %d has unknown type %q", i, requirement.Type))
+		}
+		if requirement.Amount <= 0 {
+			errors = append(errors, fmt.Sprintf("Resource requirement %d amount must be positive", i))
+		}
+		if requirement.Unit == "" {
+			errors = append(errors, fmt.Sprintf("Resource requirement %d requires a unit", i))
+		}
+	}
+
+	for i, requirement := range message.StateRequirements {
+		switch requirement.Type {
+		case "read", "write":
+			if len(requirement.Keys) == 0 {
+				errors = append(errors, fmt.Sprintf("State requirement %d of type %s requires at least one key", i, requirement.Type))
+			}
+		case "exclusive", "shared":
+		default:
+			errors = append(errors, fmt.Sprintf("State requirement %d has unknown type %q", i, requirement.Type))
+		}
+		switch requirement.Consistency {
+		case "eventual", "strong", "causal":
+		default:
+			errors = append(errors, fmt.Sprintf("State requirement %d has unknown consistency %q", i, requirement.Consistency))
+		}
+	}
+
 	return errors
 }
 
@@ -1230,10 +2632,15 @@ func intPtr(i int) *int {
 	return &i
 }
 
+// messagePriorityPtr returns a pointer to a message priority
+func messagePriorityPtr(p MessagePriority) *MessagePriority {
+	return &p
+}
+
 // stringPtr returns a pointer to a string
 func stringPtr(s string) *string {
 	return &s
 }
 
 // Default utilities instance
-var Utils A2AUtils
\ No newline at end of file
+var Utils A2AUtils
@@ -0,0 +1,139 @@
+package a2aclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// AgentMetrics is an agent's current resource usage and activity
+type AgentMetrics struct {
+	AgentID        string
+	CPU            float64 // percent of one core
+	Memory         float64 // megabytes
+	TasksPerMinute float64
+	Errors         int64
+	Uptime         time.Duration
+}
+
+// agentMetricsWire mirrors the wire format, where uptime is milliseconds
+type agentMetricsWire struct {
+	AgentID        string    `json:"agent_id"`
+	CPU            float64   `json:"cpu"`
+	Memory         float64   `json:"memory"`
+	TasksPerMinute float64   `json:"tasks_per_minute"`
+	Errors         int64     `json:"errors"`
+	Uptime         *float64  `json:"uptime"`
+	Error          *A2AError `json:"error,omitempty"`
+}
+
+// metrics converts the wire format to AgentMetrics
+func (w *agentMetricsWire) metrics() *AgentMetrics {
+	return &AgentMetrics{
+		AgentID:        w.AgentID,
+		CPU:            w.CPU,
+		Memory:         w.Memory,
+		TasksPerMinute: w.TasksPerMinute,
+		Errors:         w.Errors,
+		Uptime:         millisToDuration(w.Uptime),
+	}
+}
+
+// errAgentNotFound is returned when the metrics of an unknown agent are
+// requested
+func errAgentNotFound(agentID string) error {
+	return NewA2AClientError(CodeAgentNotFound, fmt.Sprintf("Agent %s not found", agentID), agentID)
+}
+
+// GetAgentMetrics fetches an agent's current metrics from agent_metrics. An
+// unknown agent gives an AGENT_NOT_FOUND error.
+func (c *A2AClient) GetAgentMetrics(ctx context.Context, agentID string) (*AgentMetrics, error) {
+	message := &A2AMessage{
+		Target:   Utils.SingleTarget(agentID),
+		ToolName: MCPToolClaudeFlowAgentMetrics,
+		Parameters: map[string]interface{}{
+			"agentId": agentID,
+		},
+		Coordination: CoordinationMode{
+			DirectCoordination: &DirectCoordination{
+				Mode: "direct",
+			},
+		},
+	}
+
+	response, err := c.SendMessage(ctx, message)
+	if err != nil {
+		return nil, err
+	}
+	if err := responseError(response); err != nil {
+		if isAgentNotFound(err) {
+			return nil, errAgentNotFound(agentID)
+		}
+		return nil, err
+	}
+	if response.Result == nil {
+		return nil, errAgentNotFound(agentID)
+	}
+
+	var wire agentMetricsWire
+	if err := decodeMap(response.Result, &wire); err != nil {
+		return nil, NewA2AClientError(CodeDecode, fmt.Sprintf("Failed to decode agent metrics: %v", err), response.Result)
+	}
+	if wire.AgentID == "" {
+		wire.AgentID = agentID
+	}
+	return wire.metrics(), nil
+}
+
+// GetGroupMetrics fetches the metrics of every agent in a group, keyed by
+// agent ID. Agents that reply with an error, such as having left the swarm
+// since being selected, are left out.
+func (c *A2AClient) GetGroupMetrics(ctx context.Context, target GroupTarget) (map[string]*AgentMetrics, error) {
+	if target.Type == "" {
+		target.Type = "group"
+	}
+	message := &A2AMessage{
+		Target:   AgentTarget{GroupTarget: &target},
+		ToolName: MCPToolClaudeFlowAgentMetrics,
+		Coordination: CoordinationMode{
+			BroadcastCoordination: &BroadcastCoordination{
+				Mode:           "broadcast",
+				Aggregation:    "all",
+				PartialSuccess: true,
+			},
+		},
+	}
+
+	response, err := c.SendMessage(ctx, message)
+	if err != nil {
+		return nil, err
+	}
+	if err := responseError(response); err != nil {
+		return nil, err
+	}
+
+	replies := response.Result
+	if wrapper, ok := replies.(map[string]interface{}); ok {
+		if agents, ok := wrapper["agents"]; ok {
+			replies = agents
+		}
+	}
+	var wires []agentMetricsWire
+	if err := decodeMap(replies, &wires); err != nil {
+		return nil, NewA2AClientError(CodeDecode, fmt.Sprintf("Failed to decode group metrics: %v", err), response.Result)
+	}
+
+	metrics := make(map[string]*AgentMetrics, len(wires))
+	for i := range wires {
+		if wires[i].Error != nil || wires[i].AgentID == "" {
+			continue
+		}
+		metrics[wires[i].AgentID] = wires[i].metrics()
+	}
+	return metrics, nil
+}
+
+// isAgentNotFound reports whether err is a server error for an unknown agent
+func isAgentNotFound(err error) bool {
+	return HasCode(err, CodeAgentNotFound) || HasCode(err, "NOT_FOUND")
+}
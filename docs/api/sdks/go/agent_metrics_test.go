@@ -0,0 +1,60 @@
+package a2aclient
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestGetAgentMetrics(t *testing.T) {
+	client := memoryClient(func(_ context.Context, message *A2AMessage) (*A2AResponse, error) {
+		switch message.Parameters["agentId"] {
+		case "gone":
+			return &A2AResponse{Error: &A2AError{Code: "NOT_FOUND", Message: "no such agent"}}, nil
+		case "silent":
+			return &A2AResponse{Success: true}, nil
+		}
+		return &A2AResponse{Success: true, Result: map[string]interface{}{
+			"cpu": 12.5, "memory": 256, "tasks_per_minute": 3, "errors": 1, "uptime": 90000,
+		}}, nil
+	}, nil)
+	ctx := context.Background()
+
+	for _, agentID := range []string{"gone", "silent"} {
+		if _, err := client.GetAgentMetrics(ctx, agentID); !HasCode(err, CodeAgentNotFound) {
+			t.Errorf("%s: got %v, want AGENT_NOT_FOUND", agentID, err)
+		}
+	}
+
+	metrics, err := client.GetAgentMetrics(ctx, "agent-1")
+	if err != nil {
+		t.Fatalf("GetAgentMetrics: %v", err)
+	}
+	want := AgentMetrics{AgentID: "agent-1", CPU: 12.5, Memory: 256, TasksPerMinute: 3, Errors: 1, Uptime: 90 * time.Second}
+	if *metrics != want {
+		t.Errorf("metrics %+v, want %+v", *metrics, want)
+	}
+}
+
+func TestGetGroupMetricsSkipsFailedAgents(t *testing.T) {
+	var sent *A2AMessage
+	client := memoryClient(func(_ context.Context, message *A2AMessage) (*A2AResponse, error) {
+		sent = message
+		return &A2AResponse{Success: true, Result: map[string]interface{}{"agents": []interface{}{
+			map[string]interface{}{"agent_id": "a", "cpu": 10},
+			map[string]interface{}{"agent_id": "b", "error": map[string]interface{}{"code": "NOT_FOUND", "message": "left the swarm"}},
+			map[string]interface{}{"cpu": 5},
+		}}}, nil
+	}, nil)
+
+	metrics, err := client.GetGroupMetrics(context.Background(), GroupTarget{Role: AgentRoleCoder})
+	if err != nil {
+		t.Fatalf("GetGroupMetrics: %v", err)
+	}
+	if len(metrics) != 1 || metrics["a"] == nil || metrics["a"].CPU != 10 {
+		t.Errorf("metrics %v, want only agent a", metrics)
+	}
+	if sent.Target.GroupTarget.Type != "group" || sent.Coordination.BroadcastCoordination == nil || !sent.Coordination.BroadcastCoordination.PartialSuccess {
+		t.Errorf("sent to %+v with %+v, want a partial-success broadcast to the group", sent.Target.GroupTarget, sent.Coordination)
+	}
+}
@@ -0,0 +1,104 @@
+package a2aclient
+
+import (
+	"context"
+)
+
+// ListAgentsPaged lists one page of agents matching filter, starting at
+// pageToken ("" for the first page). A pageSize of zero or less leaves the
+// page size to the server. The returned token fetches the next page and is
+// empty on the last one.
+func (c *A2AClient) ListAgentsPaged(ctx context.Context, filter *AgentFilter, pageToken string, pageSize int) ([]AgentIdentifier, string, error) {
+	message := listAgentsMessage(filter)
+	if pageToken != "" {
+		message.Parameters["pageToken"] = pageToken
+	}
+	if pageSize > 0 {
+		message.Parameters["pageSize"] = pageSize
+	}
+
+	response, err := c.SendMessage(ctx, message)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := responseError(response); err != nil {
+		return nil, "", err
+	}
+
+	agents, err := decodeAgents(response.Result)
+	if err != nil {
+		return nil, "", err
+	}
+	var nextPageToken string
+	if result, ok := response.Result.(map[string]interface{}); ok {
+		nextPageToken, _ = result["nextPageToken"].(string)
+	}
+	return agents, nextPageToken, nil
+}
+
+// AgentIterator walks every page of an agent listing, fetching each page
+// only when the previous one has been consumed:
+//
+//	it := client.IterateAgents(filter, 100)
+//	for it.Next(ctx) {
+//		agent := it.Agent()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type AgentIterator struct {
+	client    *A2AClient
+	filter    *AgentFilter
+	pageSize  int
+	pageToken string
+	page      []AgentIdentifier
+	current   AgentIdentifier
+	started   bool
+	err       error
+}
+
+// IterateAgents returns an iterator over all agents matching filter,
+// requesting pageSize agents per page
+func (c *A2AClient) IterateAgents(filter *AgentFilter, pageSize int) *AgentIterator {
+	return &AgentIterator{
+		client:   c,
+		filter:   filter,
+		pageSize: pageSize,
+	}
+}
+
+// Next advances to the next agent, fetching the next page if needed. It
+// returns false when there are no more agents or a page request failed.
+func (it *AgentIterator) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+	// Skip empty pages the server may return before the last one
+	for len(it.page) == 0 {
+		if it.started && it.pageToken == "" {
+			return false
+		}
+		it.started = true
+
+		page, next, err := it.client.ListAgentsPaged(ctx, it.filter, it.pageToken, it.pageSize)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.page, it.pageToken = page, next
+	}
+
+	it.current, it.page = it.page[0], it.page[1:]
+	return true
+}
+
+// Agent returns the agent Next advanced to
+func (it *AgentIterator) Agent() AgentIdentifier {
+	return it.current
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *AgentIterator) Err() error {
+	return it.err
+}
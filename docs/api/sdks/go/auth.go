@@ -0,0 +1,77 @@
+package a2aclient
+
+import (
+	"net/http"
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+// tokenCache holds the token from the configured TokenSource while it is
+// valid, and lets a request refused with 401 ask for a new one
+type tokenCache struct {
+	mu     sync.Mutex
+	source oauth2.TokenSource
+	token  *oauth2.Token
+}
+
+// get returns the cached token, fetching a new one once it has expired
+func (t *tokenCache) get() (*oauth2.Token, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.token.Valid() {
+		return t.token, nil
+	}
+	token, err := t.source.Token()
+	if err != nil {
+		return nil, wrapError(CodeAuthFailed, "Failed to obtain OAuth2 token", err)
+	}
+	t.token = token
+	return token, nil
+}
+
+// refresh discards stale, a token the server refused, and fetches a new one.
+// If another request already replaced it, that token is returned instead. It
+// reports false when no different token can be had, e.g. because the source
+// is an oauth2.ReuseTokenSource still holding the refused one, so retrying
+// would be pointless.
+func (t *tokenCache) refresh(stale *oauth2.Token) (*oauth2.Token, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.token != nil && t.token.AccessToken != stale.AccessToken {
+		return t.token, true
+	}
+	t.token = nil
+	token, err := t.source.Token()
+	if err != nil || token.AccessToken == stale.AccessToken {
+		return nil, false
+	}
+	t.token = token
+	return token, true
+}
+
+// reset discards the cached token, so the next request fetches a new one
+func (t *tokenCache) reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.token = nil
+}
+
+// authorize sets the Authorization header from the TokenSource, if one is
+// configured, returning the token used
+func (c *A2AClient) authorize(headers http.Header) (*oauth2.Token, error) {
+	if c.tokens == nil {
+		return nil, nil
+	}
+	token, err := c.tokens.get()
+	if err != nil {
+		return nil, err
+	}
+	setBearer(headers, token)
+	return token, nil
+}
+
+// setBearer sets the Authorization header for token
+func setBearer(headers http.Header, token *oauth2.Token) {
+	headers.Set("Authorization", token.Type()+" "+token.AccessToken)
+}
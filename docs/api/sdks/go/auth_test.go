@@ -0,0 +1,60 @@
+package a2aclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+// countingTokenSource hands out token-1, token-2 and so on
+type countingTokenSource struct {
+	mu    sync.Mutex
+	calls int
+}
+
+func (s *countingTokenSource) Token() (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls++
+	return &oauth2.Token{AccessToken: fmt.Sprintf("token-%d", s.calls), TokenType: "Bearer"}, nil
+}
+
+func TestRotatedTokenPickedUpAfter401(t *testing.T) {
+	var mu sync.Mutex
+	var seen []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		seen = append(seen, r.Header.Get("Authorization"))
+		mu.Unlock()
+		// token-1 has been revoked by rotation
+		if r.Header.Get("Authorization") != "Bearer token-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		io.WriteString(w, okResponse)
+	}))
+	defer server.Close()
+
+	source := &countingTokenSource{}
+	client := NewA2AClient(&A2AClientConfig{BaseURL: server.URL, TokenSource: source, RetryPolicy: fastRetries(0)})
+	for i := 0; i < 2; i++ {
+		if _, err := client.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowAgentList, nil)); err != nil {
+			t.Fatalf("send %d: %v", i+1, err)
+		}
+	}
+
+	want := []string{"Bearer token-1", "Bearer token-2", "Bearer token-2"}
+	if !reflect.DeepEqual(seen, want) {
+		t.Errorf("server saw %v, want %v", seen, want)
+	}
+	if source.calls != 2 {
+		t.Errorf("token source called %d times, want 2", source.calls)
+	}
+}
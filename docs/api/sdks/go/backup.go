@@ -0,0 +1,112 @@
+package a2aclient
+
+import (
+	"context"
+	"fmt"
+)
+
+// backupTimeout is the execution timeout for backups and restores, which copy
+// all selected system state
+const backupTimeout = 1800
+
+// Backup components selectable in BackupConfig and RestoreConfig
+const (
+	BackupMemory       = "memory"
+	BackupWorkflows    = "workflows"
+	BackupNeuralModels = "neural_models"
+)
+
+// BackupConfig represents a system state backup
+type BackupConfig struct {
+	Components  []string // BackupMemory, BackupWorkflows, BackupNeuralModels; empty includes all
+	Destination string   // optional location to write the backup to, e.g. a path or bucket URL
+}
+
+// RestoreConfig represents a restore from a backup
+type RestoreConfig struct {
+	Components []string // components to restore; empty restores all in the backup
+	DryRun     bool     // validate the backup and report what would change without applying it
+}
+
+// CreateBackup backs up the selected system state. The backup ID is in the
+// response's result.
+func (c *A2AClient) CreateBackup(ctx context.Context, config BackupConfig) (*A2AResponse, error) {
+	if err := validateBackupComponents(config.Components); err != nil {
+		return nil, err
+	}
+
+	params := map[string]interface{}{
+		"components": backupComponents(config.Components),
+	}
+	if config.Destination != "" {
+		params["destination"] = config.Destination
+	}
+	return c.SendMessage(ctx, backupMessage(AgentRoleSystemArchitect, MCPToolClaudeFlowBackupCreate, params))
+}
+
+// RestoreSystem restores system state from a backup. With DryRun the server
+// only validates the backup and reports what would be restored.
+func (c *A2AClient) RestoreSystem(ctx context.Context, backupID string, config RestoreConfig) (*A2AResponse, error) {
+	if backupID == "" {
+		return nil, NewA2AClientError(CodeValidation, "Backup ID is required", nil)
+	}
+	if err := validateBackupComponents(config.Components); err != nil {
+		return nil, err
+	}
+
+	params := map[string]interface{}{
+		"backupId": backupID,
+		"dryRun":   config.DryRun,
+	}
+	if len(config.Components) > 0 {
+		params["components"] = config.Components
+	}
+	return c.SendMessage(ctx, backupMessage(AgentRoleCoordinator, MCPToolClaudeFlowRestoreSystem, params))
+}
+
+// validateBackupComponents rejects unknown backup components
+func validateBackupComponents(components []string) error {
+	for _, component := range components {
+		switch component {
+		case BackupMemory, BackupWorkflows, BackupNeuralModels:
+		default:
+			return NewA2AClientError(CodeValidation, fmt.Sprintf("Unknown backup component %q", component), component)
+		}
+	}
+	return nil
+}
+
+// backupComponents returns the selected components, or all of them if none
+// are selected
+func backupComponents(components []string) []string {
+	if len(components) == 0 {
+		return []string{BackupMemory, BackupWorkflows, BackupNeuralModels}
+	}
+	return components
+}
+
+// backupMessage builds a backup or restore message for a single agent of the
+// given role
+func backupMessage(role AgentRole, toolName MCPToolName, params map[string]interface{}) *A2AMessage {
+	return &A2AMessage{
+		Target: AgentTarget{
+			GroupTarget: &GroupTarget{
+				Type:              "group",
+				Role:              role,
+				MaxAgents:         intPtr(1),
+				SelectionStrategy: "load-balanced",
+			},
+		},
+		ToolName:   toolName,
+		Parameters: params,
+		Execution: &ExecutionContext{
+			Timeout: intPtr(backupTimeout),
+		},
+		Coordination: CoordinationMode{
+			DirectCoordination: &DirectCoordination{
+				Mode:    "direct",
+				Timeout: intPtr(backupTimeout),
+			},
+		},
+	}
+}
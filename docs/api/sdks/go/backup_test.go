@@ -0,0 +1,53 @@
+package a2aclient
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestBackupAndRestore(t *testing.T) {
+	var sent *A2AMessage
+	client := memoryClient(func(_ context.Context, message *A2AMessage) (*A2AResponse, error) {
+		sent = message
+		return echoResult(message), nil
+	}, nil)
+	ctx := context.Background()
+
+	if _, err := client.CreateBackup(ctx, BackupConfig{Components: []string{BackupMemory, "logs"}}); !HasCode(err, CodeValidation) {
+		t.Errorf("unknown component: got %v, want VALIDATION_ERROR", err)
+	}
+	if _, err := client.RestoreSystem(ctx, "", RestoreConfig{}); !HasCode(err, CodeValidation) {
+		t.Errorf("no backup ID: got %v, want VALIDATION_ERROR", err)
+	}
+	if sent != nil {
+		t.Fatal("an invalid request was sent")
+	}
+
+	if _, err := client.CreateBackup(ctx, BackupConfig{Destination: "s3://backups"}); err != nil {
+		t.Fatalf("CreateBackup: %v", err)
+	}
+	want := []interface{}{BackupMemory, BackupWorkflows, BackupNeuralModels}
+	if !reflect.DeepEqual(sent.Parameters["components"], want) || sent.Parameters["destination"] != "s3://backups" {
+		t.Errorf("backup params %v, want every component", sent.Parameters)
+	}
+	if sent.ToolName != MCPToolClaudeFlowBackupCreate || sent.Target.GroupTarget.Role != AgentRoleSystemArchitect {
+		t.Errorf("backup sent %s to %s", sent.ToolName, sent.Target.GroupTarget.Role)
+	}
+	if sent.Execution == nil || *sent.Execution.Timeout != backupTimeout {
+		t.Errorf("execution %+v, want the %ds backup timeout", sent.Execution, backupTimeout)
+	}
+
+	if _, err := client.RestoreSystem(ctx, "b-1", RestoreConfig{DryRun: true}); err != nil {
+		t.Fatalf("RestoreSystem: %v", err)
+	}
+	if sent.Parameters["backupId"] != "b-1" || sent.Parameters["dryRun"] != true {
+		t.Errorf("restore params %v", sent.Parameters)
+	}
+	if _, ok := sent.Parameters["components"]; ok {
+		t.Errorf("restore params %v, want components left to the backup", sent.Parameters)
+	}
+	if sent.ToolName != MCPToolClaudeFlowRestoreSystem || sent.Target.GroupTarget.Role != AgentRoleCoordinator {
+		t.Errorf("restore sent %s to %s", sent.ToolName, sent.Target.GroupTarget.Role)
+	}
+}
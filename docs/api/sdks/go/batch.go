@@ -0,0 +1,143 @@
+package a2aclient
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// defaultToolCooldown is how long a tripped tool breaker fails items fast
+// when BatchOptions.ToolCooldown is not set
+const defaultToolCooldown = 30 * time.Second
+
+// BatchOptions configures ExecuteBatch
+type BatchOptions struct {
+	// Concurrency bounds how many messages are in flight at once (default 1)
+	Concurrency int
+	// ToolFailureThreshold is the number of consecutive failures for a tool
+	// after which batch items for that tool fail fast with CIRCUIT_OPEN
+	// instead of being sent. Failures are counted by the client across
+	// batches. Zero disables the per-tool breaker.
+	ToolFailureThreshold int
+	// ToolCooldown is how long a tripped tool breaker fails items fast
+	// before letting them through again (default 30s)
+	ToolCooldown time.Duration
+}
+
+// toolBreakers tracks consecutive batch failures per tool for the client
+type toolBreakers struct {
+	mu        sync.Mutex
+	failures  map[MCPToolName]int
+	trippedAt map[MCPToolName]time.Time
+}
+
+func newToolBreakers() *toolBreakers {
+	return &toolBreakers{
+		failures:  make(map[MCPToolName]int),
+		trippedAt: make(map[MCPToolName]time.Time),
+	}
+}
+
+// open reports whether the tool has failed threshold times in a row, the
+// last time within cooldown
+func (b *toolBreakers) open(tool MCPToolName, threshold int, cooldown time.Duration) bool {
+	if threshold <= 0 {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.failures[tool] >= threshold && time.Since(b.trippedAt[tool]) < cooldown
+}
+
+// record updates the tool's consecutive failure count with an outcome,
+// tripping the breaker when the count reaches threshold
+func (b *toolBreakers) record(tool MCPToolName, failed bool, threshold int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !failed {
+		delete(b.failures, tool)
+		delete(b.trippedAt, tool)
+		return
+	}
+	b.failures[tool]++
+	if threshold > 0 && b.failures[tool] >= threshold {
+		b.trippedAt[tool] = time.Now()
+	}
+}
+
+// reset forgets every tool's failures
+func (b *toolBreakers) reset() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures = make(map[MCPToolName]int)
+	b.trippedAt = make(map[MCPToolName]time.Time)
+}
+
+// ExecuteBatch sends every message through the normal retry path with bounded
+// concurrency. Responses and errors are index-aligned with messages. Before
+// each item is sent the client's circuit breaker and the item's tool breaker
+// are checked: while either is open, items fail immediately with
+// CIRCUIT_OPEN, and with a tool breaker open items for other tools continue.
+// If ctx is cancelled, no new messages are dispatched and the unsent items
+// fail with ctx.Err().
+func (c *A2AClient) ExecuteBatch(ctx context.Context, messages []*A2AMessage, options BatchOptions) ([]*A2AResponse, []error) {
+	responses := make([]*A2AResponse, len(messages))
+	errs := make([]error, len(messages))
+
+	concurrency := options.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	cooldown := options.ToolCooldown
+	if cooldown <= 0 {
+		cooldown = defaultToolCooldown
+	}
+
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, message := range messages {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		// The select may take a free slot even once ctx is done
+		if err := ctx.Err(); err != nil {
+			for j := i; j < len(messages); j++ {
+				errs[j] = err
+			}
+			break
+		}
+
+		switch {
+		case c.CircuitState() == CircuitOpen:
+			errs[i] = NewA2AClientError(CodeCircuitOpen, "Circuit breaker is open after repeated transport failures", nil)
+		case c.toolBreakers.open(message.ToolName, options.ToolFailureThreshold, cooldown):
+			errs[i] = NewA2AClientError(CodeCircuitOpen, "Circuit open for tool "+string(message.ToolName), message.ToolName)
+		}
+		if errs[i] != nil {
+			c.deadLetter(message, errs[i])
+			<-sem
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, message *A2AMessage) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			response, err := c.SendMessage(ctx, message)
+			responses[i], errs[i] = response, err
+			c.toolBreakers.record(message.ToolName, err != nil || !response.Success, options.ToolFailureThreshold)
+		}(i, message)
+	}
+
+	wg.Wait()
+	return responses, errs
+}
+
+// SendBatch sends messages with at most concurrency in flight, like
+// ExecuteBatch without the per-tool breaker
+func (c *A2AClient) SendBatch(ctx context.Context, messages []*A2AMessage, concurrency int) ([]*A2AResponse, []error) {
+	return c.ExecuteBatch(ctx, messages, BatchOptions{Concurrency: concurrency})
+}
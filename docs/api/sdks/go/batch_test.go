@@ -0,0 +1,143 @@
+package a2aclient
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestToolBreakerSpansBatches(t *testing.T) {
+	var sends int32
+	client := memoryClient(func(context.Context, *A2AMessage) (*A2AResponse, error) {
+		atomic.AddInt32(&sends, 1)
+		return &A2AResponse{Success: false, Error: &A2AError{Code: "TOOL_FAILED", Message: "failed"}}, nil
+	}, nil)
+	options := BatchOptions{ToolFailureThreshold: 2}
+	ctx := context.Background()
+
+	client.ExecuteBatch(ctx, []*A2AMessage{
+		directMessage(MCPToolClaudeFlowSwarmStatus, nil),
+		directMessage(MCPToolClaudeFlowSwarmStatus, nil),
+	}, options)
+	_, errs := client.ExecuteBatch(ctx, []*A2AMessage{directMessage(MCPToolClaudeFlowSwarmStatus, nil)}, options)
+	if !HasCode(errs[0], CodeCircuitOpen) {
+		t.Errorf("got %v, want CIRCUIT_OPEN from the failures of the earlier batch", errs[0])
+	}
+	if sends != 2 {
+		t.Errorf("server saw %d sends, want 2", sends)
+	}
+
+	client.Reset()
+	if _, errs := client.ExecuteBatch(ctx, []*A2AMessage{directMessage(MCPToolClaudeFlowSwarmStatus, nil)}, options); HasCode(errs[0], CodeCircuitOpen) {
+		t.Error("tool breaker still open after Reset")
+	}
+}
+
+func TestToolBreakerCoolsDown(t *testing.T) {
+	breakers := newToolBreakers()
+	breakers.record(MCPToolClaudeFlowSwarmStatus, true, 1)
+	if !breakers.open(MCPToolClaudeFlowSwarmStatus, 1, time.Hour) {
+		t.Error("breaker not open after reaching the threshold")
+	}
+	if breakers.open(MCPToolClaudeFlowSwarmStatus, 1, 0) {
+		t.Error("breaker still open after its cooldown")
+	}
+	breakers.record(MCPToolClaudeFlowSwarmStatus, false, 1)
+	if breakers.open(MCPToolClaudeFlowSwarmStatus, 1, time.Hour) {
+		t.Error("breaker open after a success")
+	}
+}
+
+func TestExecuteBatchChecksClientCircuitBreaker(t *testing.T) {
+	var sends int32
+	client := memoryClient(func(context.Context, *A2AMessage) (*A2AResponse, error) {
+		atomic.AddInt32(&sends, 1)
+		return nil, NewA2AClientError(CodeConnectionFailed, "refused", nil)
+	}, func(config *A2AClientConfig) {
+		config.RetryPolicy = fastRetries(0)
+		config.CircuitBreaker = &CircuitBreakerConfig{FailureThreshold: 1, Cooldown: time.Hour, HalfOpenProbes: 1}
+	})
+
+	messages := make([]*A2AMessage, 5)
+	for i := range messages {
+		messages[i] = directMessage(MCPToolClaudeFlowAgentSpawn, nil)
+	}
+	_, errs := client.ExecuteBatch(context.Background(), messages, BatchOptions{})
+	for i, err := range errs[1:] {
+		if !HasCode(err, CodeCircuitOpen) {
+			t.Errorf("item %d: got %v, want CIRCUIT_OPEN", i+1, err)
+		}
+	}
+	if sends != 1 {
+		t.Errorf("server saw %d sends, want 1", sends)
+	}
+}
+
+func TestExecuteBatchAlignsResults(t *testing.T) {
+	client := memoryClient(func(_ context.Context, message *A2AMessage) (*A2AResponse, error) {
+		// Later messages finish first
+		index := int(message.Parameters["index"].(float64))
+		time.Sleep(time.Duration(10-index) * time.Millisecond)
+		if index%3 == 0 {
+			return nil, NewA2AClientError(CodeValidation, "rejected", index)
+		}
+		return echoResult(message), nil
+	}, func(config *A2AClientConfig) {
+		config.RetryPolicy = fastRetries(0)
+	})
+
+	messages := make([]*A2AMessage, 10)
+	for i := range messages {
+		messages[i] = directMessage(MCPToolClaudeFlowSwarmStatus, map[string]interface{}{"index": i})
+	}
+	responses, errs := client.ExecuteBatch(context.Background(), messages, BatchOptions{Concurrency: 4})
+
+	for i, message := range messages {
+		if i%3 == 0 {
+			if responses[i] != nil || !HasCode(errs[i], CodeValidation) {
+				t.Errorf("item %d: got %v, %v, want VALIDATION", i, responses[i], errs[i])
+			}
+			continue
+		}
+		if errs[i] != nil || responses[i] == nil || responses[i].MessageID != message.ID {
+			t.Errorf("item %d: got %v, %v, want the response to %s", i, responses[i], errs[i], message.ID)
+		}
+	}
+}
+
+func TestExecuteBatchStopsOnCancel(t *testing.T) {
+	var sends int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+	client := memoryClient(func(_ context.Context, message *A2AMessage) (*A2AResponse, error) {
+		if atomic.AddInt32(&sends, 1) == 1 {
+			close(started)
+			<-release
+		}
+		return echoResult(message), nil
+	}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		cancel()
+		close(release)
+	}()
+
+	messages := make([]*A2AMessage, 5)
+	for i := range messages {
+		messages[i] = directMessage(MCPToolClaudeFlowSwarmStatus, nil)
+	}
+	_, errs := client.ExecuteBatch(ctx, messages, BatchOptions{Concurrency: 1})
+
+	for i := 1; i < len(messages); i++ {
+		if !errors.Is(errs[i], context.Canceled) {
+			t.Errorf("item %d: got %v, want context.Canceled", i, errs[i])
+		}
+	}
+	if sends != 1 {
+		t.Errorf("server saw %d sends, want only the one in flight when cancelled", sends)
+	}
+}
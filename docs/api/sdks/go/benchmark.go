@@ -0,0 +1,144 @@
+package a2aclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// benchmarkTimeout is the execution timeout for benchmark runs
+const benchmarkTimeout = 900
+
+// BenchmarkConfig represents a benchmark run
+type BenchmarkConfig struct {
+	Suite      string       // e.g. "all", "wasm", "swarm", "agent", "task"
+	Iterations int          // 0 leaves the count to the server
+	Warmup     int          // iterations run and discarded before measuring
+	Baseline   string       // optional ID of an earlier run to compare against
+	Target     *AgentTarget // defaults to a performance-monitor group
+}
+
+// BenchmarkResult is the decoded result of a benchmark run
+type BenchmarkResult struct {
+	Suite      string
+	Iterations int
+	Baseline   string
+	Operations []BenchmarkOperation
+}
+
+// BenchmarkOperation holds the timings of one benchmarked operation. The
+// baseline fields are zero when the run was not compared to a baseline.
+type BenchmarkOperation struct {
+	Name         string
+	Mean         time.Duration
+	Min          time.Duration
+	Max          time.Duration
+	P95          time.Duration
+	OpsPerSecond float64
+	BaselineMean time.Duration
+	Change       float64 // relative change in Mean against the baseline; negative is faster
+}
+
+// benchmarkResultWire mirrors the wire format, where timings are milliseconds
+type benchmarkResultWire struct {
+	Suite      string `json:"suite"`
+	Iterations int    `json:"iterations"`
+	Baseline   string `json:"baseline"`
+	Operations []struct {
+		Name         string   `json:"name"`
+		Mean         *float64 `json:"mean"`
+		Min          *float64 `json:"min"`
+		Max          *float64 `json:"max"`
+		P95          *float64 `json:"p95"`
+		OpsPerSecond float64  `json:"ops_per_second"`
+		BaselineMean *float64 `json:"baseline_mean"`
+		Change       float64  `json:"change"`
+	} `json:"operations"`
+}
+
+// RunBenchmark runs a benchmark suite and decodes the timings. The run is
+// attempted only once, whatever the client's retry policy, so a failure
+// never runs the suite twice.
+func (c *A2AClient) RunBenchmark(ctx context.Context, config BenchmarkConfig) (*BenchmarkResult, error) {
+	if config.Iterations < 0 || config.Warmup < 0 {
+		return nil, NewA2AClientError(CodeValidation, "Benchmark iterations and warmup must not be negative", nil)
+	}
+
+	target := AgentTarget{
+		GroupTarget: &GroupTarget{
+			Type:              "group",
+			Role:              AgentRolePerformanceMonitor,
+			MaxAgents:         intPtr(1),
+			SelectionStrategy: "load-balanced",
+		},
+	}
+	if config.Target != nil {
+		target = *config.Target
+	}
+
+	suite := config.Suite
+	if suite == "" {
+		suite = "all"
+	}
+	params := map[string]interface{}{
+		"suite":  suite,
+		"warmup": config.Warmup,
+	}
+	if config.Iterations > 0 {
+		params["iterations"] = config.Iterations
+	}
+	if config.Baseline != "" {
+		params["baseline"] = config.Baseline
+	}
+
+	message := &A2AMessage{
+		Target:     target,
+		ToolName:   MCPToolClaudeFlowBenchmarkRun,
+		Parameters: params,
+		Execution: &ExecutionContext{
+			Timeout: intPtr(benchmarkTimeout),
+		},
+		Coordination: CoordinationMode{
+			DirectCoordination: &DirectCoordination{
+				Mode:    "direct",
+				Timeout: intPtr(benchmarkTimeout),
+				Retries: intPtr(0),
+			},
+		},
+	}
+
+	response, err := c.SendMessage(withoutRetries(ctx), message)
+	if err != nil {
+		return nil, err
+	}
+	if err := responseError(response); err != nil {
+		return nil, err
+	}
+
+	var wire benchmarkResultWire
+	if err := decodeMap(response.Result, &wire); err != nil {
+		return nil, NewA2AClientError(CodeDecode, fmt.Sprintf("Failed to decode benchmark result: %v", err), response.Result)
+	}
+
+	result := &BenchmarkResult{
+		Suite:      wire.Suite,
+		Iterations: wire.Iterations,
+		Baseline:   wire.Baseline,
+	}
+	if result.Suite == "" {
+		result.Suite = suite
+	}
+	for _, op := range wire.Operations {
+		result.Operations = append(result.Operations, BenchmarkOperation{
+			Name:         op.Name,
+			Mean:         millisToDuration(op.Mean),
+			Min:          millisToDuration(op.Min),
+			Max:          millisToDuration(op.Max),
+			P95:          millisToDuration(op.P95),
+			OpsPerSecond: op.OpsPerSecond,
+			BaselineMean: millisToDuration(op.BaselineMean),
+			Change:       op.Change,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,67 @@
+package a2aclient
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRunBenchmarkNeverRetries(t *testing.T) {
+	var sends int32
+	client := memoryClient(func(context.Context, *A2AMessage) (*A2AResponse, error) {
+		atomic.AddInt32(&sends, 1)
+		return nil, NewA2AClientError(CodeConnectionFailed, "refused", nil)
+	}, func(config *A2AClientConfig) {
+		config.RetryPolicy = fastRetries(3)
+	})
+
+	if _, err := client.RunBenchmark(context.Background(), BenchmarkConfig{}); !HasCode(err, CodeConnectionFailed) {
+		t.Fatalf("got %v, want CONNECTION_FAILED", err)
+	}
+	if sends != 1 {
+		t.Errorf("server saw %d attempts, want 1", sends)
+	}
+
+	// Other sends still retry
+	client.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowSwarmStatus, nil))
+	if sends != 5 {
+		t.Errorf("server saw %d attempts, want 4 more from a regular send", sends)
+	}
+}
+
+func TestRunBenchmarkDecodesTimings(t *testing.T) {
+	var sent *A2AMessage
+	client := memoryClient(func(_ context.Context, message *A2AMessage) (*A2AResponse, error) {
+		sent = message
+		return &A2AResponse{Success: true, Result: map[string]interface{}{
+			"iterations": 10,
+			"baseline":   "run-1",
+			"operations": []interface{}{map[string]interface{}{
+				"name": "spawn", "mean": 2.5, "p95": 4, "ops_per_second": 400, "baseline_mean": 5, "change": -0.5,
+			}},
+		}}, nil
+	}, nil)
+
+	if _, err := client.RunBenchmark(context.Background(), BenchmarkConfig{Warmup: -1}); !HasCode(err, CodeValidation) {
+		t.Errorf("negative warmup: got %v, want VALIDATION_ERROR", err)
+	}
+
+	result, err := client.RunBenchmark(context.Background(), BenchmarkConfig{Suite: "agent", Baseline: "run-1"})
+	if err != nil {
+		t.Fatalf("RunBenchmark: %v", err)
+	}
+	if sent.Parameters["suite"] != "agent" || sent.Parameters["baseline"] != "run-1" {
+		t.Errorf("params %v", sent.Parameters)
+	}
+	if _, ok := sent.Parameters["iterations"]; ok {
+		t.Errorf("params %v, want iterations left to the server", sent.Parameters)
+	}
+	if result.Suite != "agent" || result.Iterations != 10 || len(result.Operations) != 1 {
+		t.Fatalf("decoded %+v", result)
+	}
+	op := result.Operations[0]
+	if op.Mean != 2500*time.Microsecond || op.P95 != 4*time.Millisecond || op.BaselineMean != 5*time.Millisecond || op.Change != -0.5 {
+		t.Errorf("operation %+v", op)
+	}
+}
@@ -0,0 +1,158 @@
+package a2aclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// swarmReadyPollInterval is how often WaitForSwarmReady polls swarm status
+const swarmReadyPollInterval = 500 * time.Millisecond
+
+// BootstrapConfig describes a swarm and the team to spawn into it
+type BootstrapConfig struct {
+	Swarm SwarmConfig
+	Team  []AgentSpawnConfig
+	// ReadyTimeout bounds the wait for the swarm to become ready (default 60s)
+	ReadyTimeout time.Duration
+	// KeepOnFailure leaves a partially bootstrapped swarm in place instead of
+	// destroying it
+	KeepOnFailure bool
+}
+
+// SwarmHandle identifies a bootstrapped swarm and its agents
+type SwarmHandle struct {
+	SwarmID  string
+	AgentIDs []string
+}
+
+// BootstrapSwarm initializes a swarm, spawns its team into it and waits until
+// it is ready. If any step fails the partial swarm is destroyed, unless
+// KeepOnFailure is set, and the error is returned.
+func (c *A2AClient) BootstrapSwarm(ctx context.Context, config BootstrapConfig) (*SwarmHandle, error) {
+	response, err := c.InitializeSwarm(ctx, config.Swarm)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize swarm: %w", err)
+	}
+	if err := responseError(response); err != nil {
+		return nil, fmt.Errorf("failed to initialize swarm: %w", err)
+	}
+	swarmID := resultString(response.Result, "swarmId", "swarm_id", "id")
+	if swarmID == "" {
+		return nil, NewA2AClientError(CodeValidation, "Swarm initialization response has no swarm ID", response.Result)
+	}
+
+	handle := &SwarmHandle{SwarmID: swarmID}
+	fail := func(err error) (*SwarmHandle, error) {
+		if config.KeepOnFailure {
+			return handle, err
+		}
+		if destroyErr := c.destroySwarm(swarmID); destroyErr != nil {
+			return nil, errors.Join(err, fmt.Errorf("failed to destroy swarm %s: %w", swarmID, destroyErr))
+		}
+		return nil, err
+	}
+
+	for _, member := range config.Team {
+		member.SwarmID = swarmID
+		response, err := c.SpawnAgent(ctx, member)
+		if err == nil {
+			err = responseError(response)
+		}
+		if err != nil {
+			return fail(fmt.Errorf("failed to spawn agent %q: %w", member.Name, err))
+		}
+		handle.AgentIDs = append(handle.AgentIDs, resultString(response.Result, "agentId", "agent_id", "id"))
+	}
+
+	readyTimeout := config.ReadyTimeout
+	if readyTimeout <= 0 {
+		readyTimeout = 60 * time.Second
+	}
+	readyCtx, cancel := context.WithTimeout(ctx, readyTimeout)
+	defer cancel()
+	if err := c.WaitForSwarmReady(readyCtx, swarmID); err != nil {
+		return fail(err)
+	}
+
+	return handle, nil
+}
+
+// WaitForSwarmReady polls the swarm status until it reports "ready" or
+// "active". It fails if the swarm reports "failed" or "error", or when ctx is
+// done. Polls bypass the response cache.
+func (c *A2AClient) WaitForSwarmReady(ctx context.Context, swarmID string) error {
+	ticker := time.NewTicker(swarmReadyPollInterval)
+	defer ticker.Stop()
+
+	for {
+		response, err := c.GetSwarmStatus(WithoutCache(ctx), swarmID)
+		if err == nil {
+			err = responseError(response)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get status of swarm %s: %w", swarmID, err)
+		}
+
+		switch status := resultString(response.Result, "status"); status {
+		case "ready", "active":
+			return nil
+		case "failed", "error":
+			return NewA2AClientError(CodeSwarmFailed, fmt.Sprintf("Swarm %s reported status %q", swarmID, status), response.Result)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return fmt.Errorf("swarm %s not ready: %w", swarmID, ctx.Err())
+		}
+	}
+}
+
+// destroySwarm tears down a swarm. It uses its own timeout so cleanup still
+// runs when the bootstrap context has expired.
+func (c *A2AClient) destroySwarm(swarmID string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.Timeout)
+	defer cancel()
+
+	message := &A2AMessage{
+		Target: AgentTarget{
+			GroupTarget: &GroupTarget{
+				Type: "group",
+				Role: AgentRoleCoordinator,
+			},
+		},
+		ToolName: MCPToolClaudeFlowSwarmDestroy,
+		Parameters: map[string]interface{}{
+			"swarmId": swarmID,
+		},
+		Coordination: CoordinationMode{
+			BroadcastCoordination: &BroadcastCoordination{
+				Mode:        "broadcast",
+				Aggregation: "all",
+			},
+		},
+	}
+
+	response, err := c.SendMessage(ctx, message)
+	if err != nil {
+		return err
+	}
+	return responseError(response)
+}
+
+// resultString returns the first string value found under the given keys of
+// a map result
+func resultString(result interface{}, keys ...string) string {
+	values, ok := result.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	for _, key := range keys {
+		if value, ok := values[key].(string); ok && value != "" {
+			return value
+		}
+	}
+	return ""
+}
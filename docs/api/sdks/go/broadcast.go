@@ -0,0 +1,192 @@
+package a2aclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// AgentOutcome is one agent's part of an AggregatedResponse. Err is set if
+// the message failed to send or the agent reported an error. Agents still
+// running when "first" found its winner are marked Cancelled instead.
+type AgentOutcome struct {
+	AgentID   string       `json:"agent_id"`
+	Response  *A2AResponse `json:"response,omitempty"`
+	Err       error        `json:"-"`
+	Cancelled bool         `json:"cancelled,omitempty"`
+}
+
+// AggregatedResponse combines the replies of a client-side broadcast
+type AggregatedResponse struct {
+	Aggregation string `json:"aggregation"`
+	// Success reports whether the outcomes satisfy the aggregation mode
+	Success bool `json:"success"`
+	// Results holds the result of every agent that succeeded, by agent ID;
+	// for "first", only the winner's
+	Results map[string]interface{} `json:"results"`
+	// Outcomes has one entry per targeted agent, in listing order
+	Outcomes  []AgentOutcome `json:"outcomes"`
+	Succeeded int            `json:"succeeded"`
+	Failed    int            `json:"failed"`
+	// Cancelled counts the agents "first" stopped once it had a winner
+	Cancelled int `json:"cancelled"`
+}
+
+// BroadcastAndAggregate fans toolName out to every agent ListAgents returns
+// for filter, one direct message each sent concurrently, and combines the
+// replies locally by aggregation:
+//
+//   - "all" (the default) succeeds if every agent does
+//   - "majority" succeeds if more than half do
+//   - "any" succeeds if at least one does
+//   - "first" succeeds with the first agent that does and cancels the rest
+//
+// Every mode but "first" waits for all agents. When the outcomes do not
+// satisfy the mode, the aggregated response is returned along with an
+// A2A_REQUEST_FAILED error. If no agent matches filter, AGENT_NOT_FOUND is
+// returned.
+func (c *A2AClient) BroadcastAndAggregate(ctx context.Context, toolName MCPToolName, params map[string]interface{}, filter *AgentFilter, aggregation string) (*AggregatedResponse, error) {
+	if aggregation == "" {
+		aggregation = "all"
+	}
+	switch aggregation {
+	case "all", "majority", "any", "first":
+	default:
+		return nil, NewA2AClientError(CodeValidation, fmt.Sprintf("Unknown aggregation %q", aggregation), aggregation)
+	}
+
+	response, err := c.ListAgents(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if err := responseError(response); err != nil {
+		return nil, err
+	}
+	agents, err := decodeAgents(response.Result)
+	if err != nil {
+		return nil, NewA2AClientError(CodeDecode, err.Error(), response.Result)
+	}
+	if len(agents) == 0 {
+		return nil, NewA2AClientError(CodeAgentNotFound, "No agents match the broadcast filter", filter)
+	}
+
+	agentIDs := make([]string, len(agents))
+	for i, agent := range agents {
+		agentIDs[i] = agent.AgentID
+	}
+	outcomes, winner := c.fanOut(ctx, agentIDs, toolName, params, aggregation == "first")
+
+	aggregated, err := aggregateOutcomes(aggregation, outcomes)
+	if winner >= 0 {
+		aggregated.Results = map[string]interface{}{
+			outcomes[winner].AgentID: outcomes[winner].Response.Result,
+		}
+	}
+	return aggregated, err
+}
+
+// fanOut sends toolName to every agent in agentIDs concurrently, one direct
+// message each, and returns each agent's outcome, in agentIDs order, once
+// every send has finished. With first set, the other sends are cancelled as
+// soon as one succeeds: winner is its index, or -1 if none did, and the
+// agents stopped because of it are marked Cancelled.
+func (c *A2AClient) fanOut(ctx context.Context, agentIDs []string, toolName MCPToolName, params map[string]interface{}, first bool) (outcomes []AgentOutcome, winner int) {
+	sendCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	outcomes = make([]AgentOutcome, len(agentIDs))
+	winner = -1
+	var won sync.Once
+	var wg sync.WaitGroup
+	for i, agentID := range agentIDs {
+		wg.Add(1)
+		go func(i int, agentID string) {
+			defer wg.Done()
+			response, err := c.SendMessage(sendCtx, broadcastMemberMessage(toolName, params, agentID))
+			if err == nil {
+				err = responseError(response)
+			}
+			outcomes[i] = AgentOutcome{AgentID: agentID, Response: response, Err: err}
+			if err == nil && first {
+				won.Do(func() {
+					winner = i
+					cancel()
+				})
+			}
+		}(i, agentID)
+	}
+	wg.Wait()
+
+	if winner >= 0 && ctx.Err() == nil {
+		for i := range outcomes {
+			if i != winner && errors.Is(outcomes[i].Err, context.Canceled) {
+				outcomes[i].Err = nil
+				outcomes[i].Cancelled = true
+			}
+		}
+	}
+	return outcomes, winner
+}
+
+// broadcastMemberMessage builds the direct message sent to one agent of a
+// client-side broadcast. Each gets its own copy of params.
+func broadcastMemberMessage(toolName MCPToolName, params map[string]interface{}, agentID string) *A2AMessage {
+	parameters := make(map[string]interface{}, len(params))
+	for key, value := range params {
+		parameters[key] = value
+	}
+
+	return &A2AMessage{
+		Target: AgentTarget{
+			SingleTarget: &SingleTarget{
+				Type:    "single",
+				AgentID: agentID,
+			},
+		},
+		ToolName:   toolName,
+		Parameters: parameters,
+		Coordination: CoordinationMode{
+			DirectCoordination: &DirectCoordination{
+				Mode: "direct",
+			},
+		},
+	}
+}
+
+// aggregateOutcomes combines the outcomes of a broadcast by aggregation
+func aggregateOutcomes(aggregation string, outcomes []AgentOutcome) (*AggregatedResponse, error) {
+	aggregated := &AggregatedResponse{
+		Aggregation: aggregation,
+		Results:     make(map[string]interface{}),
+		Outcomes:    outcomes,
+	}
+	for _, outcome := range outcomes {
+		if outcome.Cancelled {
+			aggregated.Cancelled++
+			continue
+		}
+		if outcome.Err != nil {
+			aggregated.Failed++
+			continue
+		}
+		aggregated.Succeeded++
+		aggregated.Results[outcome.AgentID] = outcome.Response.Result
+	}
+
+	switch aggregation {
+	case "all":
+		aggregated.Success = aggregated.Failed == 0
+	case "majority":
+		aggregated.Success = aggregated.Succeeded*2 > len(outcomes)
+	case "any", "first":
+		aggregated.Success = aggregated.Succeeded > 0
+	}
+
+	if !aggregated.Success {
+		return aggregated, NewA2AClientError(CodeRequestFailed,
+			fmt.Sprintf("Broadcast %s aggregation not satisfied: %d of %d agents succeeded", aggregation, aggregated.Succeeded, len(outcomes)),
+			aggregated)
+	}
+	return aggregated, nil
+}
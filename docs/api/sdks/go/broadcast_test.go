@@ -0,0 +1,90 @@
+package a2aclient
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+// agentsClient returns a client whose agent listing holds agentIDs and
+// whose agents behave by the prefix of their ID: "ok" agents succeed, "bad"
+// agents report an error, and "slow" agents answer only once cancelled
+func agentsClient(agentIDs ...string) *A2AClient {
+	return memoryClient(func(ctx context.Context, message *A2AMessage) (*A2AResponse, error) {
+		if message.ToolName == MCPToolClaudeFlowAgentList {
+			agents := make([]interface{}, len(agentIDs))
+			for i, agentID := range agentIDs {
+				agents[i] = map[string]interface{}{"agent_id": agentID}
+			}
+			return &A2AResponse{Success: true, Result: agents}, nil
+		}
+
+		agentID := message.Target.SingleTarget.AgentID
+		switch {
+		case strings.HasPrefix(agentID, "ok"):
+			return &A2AResponse{Success: true, Result: agentID}, nil
+		case strings.HasPrefix(agentID, "slow"):
+			<-ctx.Done()
+			return nil, ctx.Err()
+		default:
+			return &A2AResponse{Success: false, Error: &A2AError{Code: "AGENT_FAILED", Message: agentID + " failed"}}, nil
+		}
+	}, func(config *A2AClientConfig) {
+		config.RetryPolicy = fastRetries(0)
+	})
+}
+
+func TestBroadcastAndAggregateModes(t *testing.T) {
+	tests := []struct {
+		aggregation string
+		agents      []string
+		success     bool
+		succeeded   int
+		failed      int
+		cancelled   int
+	}{
+		{"all", []string{"ok-1", "ok-2"}, true, 2, 0, 0},
+		{"all", []string{"ok-1", "bad-1"}, false, 1, 1, 0},
+		{"majority", []string{"ok-1", "ok-2", "bad-1"}, true, 2, 1, 0},
+		{"majority", []string{"ok-1", "bad-1"}, false, 1, 1, 0},
+		{"any", []string{"bad-1", "ok-1"}, true, 1, 1, 0},
+		{"any", []string{"bad-1", "bad-2"}, false, 0, 2, 0},
+		{"first", []string{"slow-1", "ok-1", "slow-2"}, true, 1, 0, 2},
+		{"first", []string{"bad-1", "bad-2"}, false, 0, 2, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.aggregation+"/"+strings.Join(tt.agents, ","), func(t *testing.T) {
+			client := agentsClient(tt.agents...)
+			aggregated, err := client.BroadcastAndAggregate(context.Background(), MCPToolClaudeFlowHealthCheck, nil, nil, tt.aggregation)
+			if tt.success != (err == nil) {
+				t.Fatalf("got error %v, want success %v", err, tt.success)
+			}
+			if tt.success != aggregated.Success {
+				t.Errorf("Success = %v", aggregated.Success)
+			}
+			if aggregated.Succeeded != tt.succeeded || aggregated.Failed != tt.failed || aggregated.Cancelled != tt.cancelled {
+				t.Errorf("got %d succeeded, %d failed, %d cancelled; want %d, %d, %d",
+					aggregated.Succeeded, aggregated.Failed, aggregated.Cancelled, tt.succeeded, tt.failed, tt.cancelled)
+			}
+			for _, outcome := range aggregated.Outcomes {
+				if outcome.Cancelled && outcome.Err != nil {
+					t.Errorf("cancelled agent %s also failed with %v", outcome.AgentID, outcome.Err)
+				}
+			}
+		})
+	}
+}
+
+func TestBroadcastFirstKeepsOnlyWinner(t *testing.T) {
+	client := agentsClient("slow-1", "ok-1")
+	aggregated, err := client.BroadcastAndAggregate(context.Background(), MCPToolClaudeFlowHealthCheck, nil, nil, "first")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(aggregated.Results) != 1 || aggregated.Results["ok-1"] != "ok-1" {
+		t.Errorf("got results %v, want only ok-1's", aggregated.Results)
+	}
+	if !aggregated.Outcomes[0].Cancelled {
+		t.Errorf("slow agent not marked cancelled: %+v", aggregated.Outcomes[0])
+	}
+}
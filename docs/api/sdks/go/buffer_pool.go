@@ -0,0 +1,81 @@
+package a2aclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"sync"
+	"sync/atomic"
+)
+
+// maxPooledBuffer is the largest buffer returned to the pool; bigger ones,
+// grown by an occasional large message, are left to the garbage collector
+// rather than held for the life of the process
+const maxPooledBuffer = 1 << 20
+
+var bufferPool = sync.Pool{
+	New: func() interface{} { return new(bytes.Buffer) },
+}
+
+// marshalPooled encodes v as JSON into a buffer from the pool. The bytes are
+// the same json.Marshal would produce. The caller must pass the buffer to
+// releaseBuffer once nothing refers to its bytes any more.
+func marshalPooled(v interface{}) (*bytes.Buffer, error) {
+	buf := bufferPool.Get().(*bytes.Buffer)
+	if err := json.NewEncoder(buf).Encode(v); err != nil {
+		releaseBuffer(buf)
+		return nil, err
+	}
+	// Encode terminates the value with a newline that Marshal does not add
+	buf.Truncate(buf.Len() - 1)
+	return buf, nil
+}
+
+// releaseBuffer resets buf and returns it to the pool
+func releaseBuffer(buf *bytes.Buffer) {
+	if buf.Cap() > maxPooledBuffer {
+		return
+	}
+	buf.Reset()
+	bufferPool.Put(buf)
+}
+
+// pooledBody shares a pooled buffer between the HTTP request bodies made
+// from it. The http.Client may still be writing a body after Do returns and
+// may ask GetBody for another one while following a redirect, so the buffer
+// is counted: the caller holds one reference until Do returns, every body
+// holds one until it is closed, and the buffer goes back to the pool when
+// the last is dropped.
+type pooledBody struct {
+	buf  *bytes.Buffer
+	refs int32
+}
+
+func newPooledBody(buf *bytes.Buffer) *pooledBody {
+	return &pooledBody{buf: buf, refs: 1}
+}
+
+// reader returns a new body reading the buffer from the start
+func (p *pooledBody) reader() io.ReadCloser {
+	atomic.AddInt32(&p.refs, 1)
+	return &pooledReader{Reader: bytes.NewReader(p.buf.Bytes()), body: p}
+}
+
+// release drops one reference
+func (p *pooledBody) release() {
+	if atomic.AddInt32(&p.refs, -1) == 0 {
+		releaseBuffer(p.buf)
+	}
+}
+
+type pooledReader struct {
+	*bytes.Reader
+	body *pooledBody
+	once sync.Once
+}
+
+// Close releases the reader's reference; it is safe to call more than once
+func (r *pooledReader) Close() error {
+	r.once.Do(r.body.release)
+	return nil
+}
@@ -0,0 +1,56 @@
+package a2aclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+// benchmarkMessage is a typical tool call for the marshal benchmarks
+func benchmarkMessage() *A2AMessage {
+	message := directMessage(MCPToolClaudeFlowTaskOrchestrate, map[string]interface{}{
+		"task":     "analyze repository",
+		"strategy": "parallel",
+		"agents":   []string{"coder-1", "reviewer-1", "tester-1"},
+	})
+	message.ID = "msg_1700000000000_abcdef12"
+	return message
+}
+
+func TestMarshalPooledMatchesMarshal(t *testing.T) {
+	message := benchmarkMessage()
+	want, err := json.Marshal(message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf, err := marshalPooled(message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer releaseBuffer(buf)
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Errorf("marshalPooled = %s, want %s", buf.Bytes(), want)
+	}
+}
+
+func BenchmarkMarshal(b *testing.B) {
+	message := benchmarkMessage()
+	b.Run("json.Marshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := json.Marshal(message); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("pooled", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			buf, err := marshalPooled(message)
+			if err != nil {
+				b.Fatal(err)
+			}
+			releaseBuffer(buf)
+		}
+	})
+}
@@ -0,0 +1,105 @@
+package a2aclient
+
+import (
+	"errors"
+	"time"
+)
+
+// MessageBuilder assembles an A2AMessage step by step, collecting errors
+// until Build is called. Build also runs Utils.ValidateMessage.
+type MessageBuilder struct {
+	message *A2AMessage
+	errs    []error
+}
+
+// NewMessage starts building a message for the given tool
+func NewMessage(toolName MCPToolName) *MessageBuilder {
+	return &MessageBuilder{
+		message: &A2AMessage{ToolName: toolName},
+	}
+}
+
+// To sets the message target
+func (b *MessageBuilder) To(target AgentTarget) *MessageBuilder {
+	b.message.Target = target
+	return b
+}
+
+// WithCoordination sets the coordination mode
+func (b *MessageBuilder) WithCoordination(coordination CoordinationMode) *MessageBuilder {
+	b.message.Coordination = coordination
+	return b
+}
+
+// WithParam sets a single tool parameter
+func (b *MessageBuilder) WithParam(key string, value interface{}) *MessageBuilder {
+	if b.message.Parameters == nil {
+		b.message.Parameters = make(map[string]interface{})
+	}
+	b.message.Parameters[key] = value
+	return b
+}
+
+// WithParams merges the given tool parameters into the message
+func (b *MessageBuilder) WithParams(params map[string]interface{}) *MessageBuilder {
+	for key, value := range params {
+		b.WithParam(key, value)
+	}
+	return b
+}
+
+// WithPriority sets the message priority
+func (b *MessageBuilder) WithPriority(priority MessagePriority) *MessageBuilder {
+	b.message.Priority = messagePriorityPtr(priority)
+	return b
+}
+
+// WithTTL sets how long, in seconds, the message stays deliverable
+func (b *MessageBuilder) WithTTL(seconds int) *MessageBuilder {
+	if seconds <= 0 {
+		b.errs = append(b.errs, NewA2AClientError(CodeValidation, "TTL must be positive", seconds))
+		return b
+	}
+	b.message.TTL = intPtr(seconds)
+	return b
+}
+
+// WithExecutionTimeout sets the execution timeout. The protocol counts whole
+// seconds, so partial seconds are rounded up.
+func (b *MessageBuilder) WithExecutionTimeout(timeout time.Duration) *MessageBuilder {
+	if timeout <= 0 {
+		b.errs = append(b.errs, NewA2AClientError(CodeValidation, "Execution timeout must be positive", timeout))
+		return b
+	}
+	seconds := int((timeout + time.Second - 1) / time.Second)
+	b.execution().Timeout = intPtr(seconds)
+	return b
+}
+
+// WithIdempotencyKey sets the key the server uses to execute repeated sends
+// of the message only once. Without it the message ID is used.
+func (b *MessageBuilder) WithIdempotencyKey(key string) *MessageBuilder {
+	b.message.IdempotencyKey = key
+	return b
+}
+
+// Build returns the assembled message, or the errors collected while
+// building it
+func (b *MessageBuilder) Build() (*A2AMessage, error) {
+	errs := b.errs
+	for _, problem := range Utils.ValidateMessage(b.message) {
+		errs = append(errs, NewA2AClientError(CodeValidation, problem, nil))
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return b.message, nil
+}
+
+// execution returns the message's execution context, creating it if needed
+func (b *MessageBuilder) execution() *ExecutionContext {
+	if b.message.Execution == nil {
+		b.message.Execution = &ExecutionContext{}
+	}
+	return b.message.Execution
+}
@@ -0,0 +1,101 @@
+package a2aclient
+
+import (
+	"context"
+	"sort"
+)
+
+// CacheKey identifies a cached memory entry
+type CacheKey struct {
+	Namespace string
+	Key       string
+}
+
+// InvalidateCacheBatch invalidates many cache keys with a single message.
+// Duplicate keys are dropped and the rest are grouped by namespace so every
+// memory manager applies them in one pass. An empty batch sends nothing and
+// returns a nil response.
+func (c *A2AClient) InvalidateCacheBatch(ctx context.Context, keys []CacheKey) (*A2AResponse, error) {
+	byNamespace := make(map[string]map[string]bool)
+	for _, key := range keys {
+		if byNamespace[key.Namespace] == nil {
+			byNamespace[key.Namespace] = make(map[string]bool)
+		}
+		byNamespace[key.Namespace][key.Key] = true
+	}
+	if len(byNamespace) == 0 {
+		return nil, nil
+	}
+
+	namespaces := make([]string, 0, len(byNamespace))
+	for namespace := range byNamespace {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+
+	entries := make([]map[string]interface{}, 0, len(namespaces))
+	requirements := make([]StateRequirement, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		namespaceKeys := make([]string, 0, len(byNamespace[namespace]))
+		for key := range byNamespace[namespace] {
+			namespaceKeys = append(namespaceKeys, key)
+		}
+		sort.Strings(namespaceKeys)
+
+		entries = append(entries, map[string]interface{}{
+			"namespace": namespace,
+			"keys":      namespaceKeys,
+		})
+		requirements = append(requirements, StateRequirement{
+			Type:        "write",
+			Namespace:   namespace,
+			Keys:        namespaceKeys,
+			Consistency: "eventual",
+		})
+	}
+
+	return c.sendInvalidation(ctx, map[string]interface{}{
+		"action":  "invalidate",
+		"entries": entries,
+	}, requirements)
+}
+
+// InvalidateNamespace invalidates every cached entry in a namespace
+func (c *A2AClient) InvalidateNamespace(ctx context.Context, namespace string) (*A2AResponse, error) {
+	return c.sendInvalidation(ctx, map[string]interface{}{
+		"action":    "invalidate",
+		"namespace": namespace,
+		"pattern":   "*",
+	}, []StateRequirement{
+		{
+			Type:        "write",
+			Namespace:   namespace,
+			Keys:        []string{"*"},
+			Consistency: "eventual",
+		},
+	})
+}
+
+// sendInvalidation broadcasts a cache invalidation to every memory manager
+func (c *A2AClient) sendInvalidation(ctx context.Context, params map[string]interface{}, requirements []StateRequirement) (*A2AResponse, error) {
+	role := AgentRoleMemoryManager
+	message := &A2AMessage{
+		Target: AgentTarget{
+			BroadcastTarget: &BroadcastTarget{
+				Type:   "broadcast",
+				Filter: &AgentFilter{Role: &role},
+			},
+		},
+		ToolName:   MCPToolClaudeFlowCacheManage,
+		Parameters: params,
+		Coordination: CoordinationMode{
+			BroadcastCoordination: &BroadcastCoordination{
+				Mode:        "broadcast",
+				Aggregation: "all",
+			},
+		},
+		StateRequirements: requirements,
+	}
+
+	return c.SendMessage(ctx, message)
+}
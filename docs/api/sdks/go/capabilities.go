@@ -0,0 +1,315 @@
+package a2aclient
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Capability Version Matching
+
+// CapabilityConstraint is a parsed capability requirement such as "pytorch",
+// "pytorch@2.1" or "pytorch>=2.0"
+type CapabilityConstraint struct {
+	Name     string `json:"name"`
+	Operator string `json:"operator,omitempty"` // "=", "!=", ">", ">=", "<", "<=", or empty for any version
+	Version  string `json:"version,omitempty"`
+}
+
+// capabilityOperators lists accepted operators, longest first so that ">="
+// is not parsed as ">"
+var capabilityOperators = []string{">=", "<=", "==", "!=", ">", "<", "=", "@"}
+
+// ParseCapabilityConstraint parses a capability entry. "@" and "==" are
+// accepted as aliases for "=". Versions are dotted numbers with an optional
+// leading "v" and "-prerelease" suffix.
+func ParseCapabilityConstraint(s string) (CapabilityConstraint, error) {
+	s = strings.TrimSpace(s)
+	index := strings.IndexAny(s, "@<>=!")
+	if index < 0 {
+		if err := validateCapabilityName(s); err != nil {
+			return CapabilityConstraint{}, err
+		}
+		return CapabilityConstraint{Name: s}, nil
+	}
+
+	name := strings.TrimSpace(s[:index])
+	if err := validateCapabilityName(name); err != nil {
+		return CapabilityConstraint{}, err
+	}
+
+	rest := s[index:]
+	for _, op := range capabilityOperators {
+		if !strings.HasPrefix(rest, op) {
+			continue
+		}
+		version := strings.TrimSpace(rest[len(op):])
+		if _, err := parseCapabilityVersion(version); err != nil {
+			return CapabilityConstraint{}, fmt.Errorf("invalid capability constraint %q: %w", s, err)
+		}
+		if op == "@" || op == "==" {
+			op = "="
+		}
+		return CapabilityConstraint{Name: name, Operator: op, Version: version}, nil
+	}
+
+	return CapabilityConstraint{}, fmt.Errorf("invalid capability constraint %q: unknown operator", s)
+}
+
+// String formats the constraint back into its textual form
+func (cc CapabilityConstraint) String() string {
+	if cc.Operator == "" {
+		return cc.Name
+	}
+	return cc.Name + cc.Operator + cc.Version
+}
+
+// Matches reports whether an advertised capability such as "pytorch@2.1"
+// satisfies the constraint. A constraint without a version matches any
+// advertised version; a versioned constraint never matches an unversioned
+// capability.
+func (cc CapabilityConstraint) Matches(advertised string) bool {
+	name, version, hasVersion := strings.Cut(advertised, "@")
+	if !strings.EqualFold(strings.TrimSpace(name), cc.Name) {
+		return false
+	}
+	if cc.Operator == "" {
+		return true
+	}
+	if !hasVersion {
+		return false
+	}
+
+	have, err := parseCapabilityVersion(strings.TrimSpace(version))
+	if err != nil {
+		return false
+	}
+	want, _ := parseCapabilityVersion(cc.Version)
+	cmp := have.compare(want)
+
+	switch cc.Operator {
+	case "=":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	}
+	return false
+}
+
+// MatchesAny reports whether any of the advertised capabilities satisfies
+// the constraint
+func (cc CapabilityConstraint) MatchesAny(advertised []string) bool {
+	for _, capability := range advertised {
+		if cc.Matches(capability) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseCapabilityConstraints parses every entry, returning the first error
+func ParseCapabilityConstraints(capabilities []string) ([]CapabilityConstraint, error) {
+	constraints := make([]CapabilityConstraint, 0, len(capabilities))
+	for _, capability := range capabilities {
+		constraint, err := ParseCapabilityConstraint(capability)
+		if err != nil {
+			return nil, err
+		}
+		constraints = append(constraints, constraint)
+	}
+	return constraints, nil
+}
+
+// validateCapabilityName checks that a capability name is non-empty and has
+// no whitespace or unsupported range operators
+func validateCapabilityName(name string) error {
+	if name == "" {
+		return fmt.Errorf("capability name is required")
+	}
+	if strings.ContainsAny(name, " \t\n") {
+		return fmt.Errorf("capability name %q contains whitespace", name)
+	}
+	if strings.ContainsAny(name, "~^") {
+		return fmt.Errorf("capability %q uses an unsupported operator", name)
+	}
+	return nil
+}
+
+// capabilityVersion is a parsed dotted version
+type capabilityVersion struct {
+	parts      []int
+	prerelease string
+}
+
+// parseCapabilityVersion parses versions like "2", "2.1", "v2.1.3" or
+// "2.1.0-rc1"
+func parseCapabilityVersion(s string) (capabilityVersion, error) {
+	if s == "" {
+		return capabilityVersion{}, fmt.Errorf("version is required")
+	}
+
+	s = strings.TrimPrefix(s, "v")
+	core, prerelease, _ := strings.Cut(s, "-")
+
+	var version capabilityVersion
+	for _, part := range strings.Split(core, ".") {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return capabilityVersion{}, fmt.Errorf("malformed version %q", s)
+		}
+		version.parts = append(version.parts, n)
+	}
+	version.prerelease = prerelease
+	return version, nil
+}
+
+// compare returns -1, 0 or 1. Missing components count as zero and a
+// prerelease sorts before the corresponding release.
+func (v capabilityVersion) compare(other capabilityVersion) int {
+	for i := 0; i < len(v.parts) || i < len(other.parts); i++ {
+		var a, b int
+		if i < len(v.parts) {
+			a = v.parts[i]
+		}
+		if i < len(other.parts) {
+			b = other.parts[i]
+		}
+		if a != b {
+			if a < b {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	switch {
+	case v.prerelease == other.prerelease:
+		return 0
+	case v.prerelease == "":
+		return 1
+	case other.prerelease == "":
+		return -1
+	case v.prerelease < other.prerelease:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// ResolveGroupTarget lists agents with the target's role and returns those
+// whose advertised capabilities satisfy every versioned constraint in
+// target.Capabilities, limited to target.MaxAgents. Only capability names
+// are sent to the server; version constraints are evaluated client-side.
+func (c *A2AClient) ResolveGroupTarget(ctx context.Context, target GroupTarget) ([]AgentIdentifier, error) {
+	constraints, err := ParseCapabilityConstraints(target.Capabilities)
+	if err != nil {
+		return nil, NewA2AClientError(CodeValidation, err.Error(), target.Capabilities)
+	}
+
+	filter := &AgentFilter{}
+	if target.Role != "" {
+		role := target.Role
+		filter.Role = &role
+	}
+	for _, constraint := range constraints {
+		filter.Capabilities = append(filter.Capabilities, constraint.Name)
+	}
+
+	response, err := c.ListAgents(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if err := responseError(response); err != nil {
+		return nil, err
+	}
+	agents, err := decodeAgents(response.Result)
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []AgentIdentifier
+	for _, agent := range agents {
+		if target.Role != "" && agent.AgentType != "" && agent.AgentType != target.Role {
+			continue
+		}
+		satisfied := true
+		for _, constraint := range constraints {
+			if !constraint.MatchesAny(agent.Capabilities) {
+				satisfied = false
+				break
+			}
+		}
+		if !satisfied {
+			continue
+		}
+		matched = append(matched, agent)
+		if target.MaxAgents != nil && *target.MaxAgents > 0 && len(matched) >= *target.MaxAgents {
+			break
+		}
+	}
+
+	return matched, nil
+}
+
+// FindAgents answers "who can do X?": it lists agents with any of the
+// required capabilities, and the given role if not nil, and returns those
+// satisfying at least one of them, most capabilities satisfied first.
+// Required capabilities may carry version constraints, which are evaluated
+// client-side. An empty slice is returned when no agent matches.
+func (c *A2AClient) FindAgents(ctx context.Context, required []string, role *AgentRole) ([]AgentIdentifier, error) {
+	constraints, err := ParseCapabilityConstraints(required)
+	if err != nil {
+		return nil, NewA2AClientError(CodeValidation, err.Error(), required)
+	}
+
+	filter := &AgentFilter{Role: role}
+	for _, constraint := range constraints {
+		filter.Capabilities = append(filter.Capabilities, constraint.Name)
+	}
+
+	response, err := c.ListAgents(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if err := responseError(response); err != nil {
+		return nil, err
+	}
+	agents, err := decodeAgents(response.Result)
+	if err != nil {
+		return nil, err
+	}
+
+	matched := []AgentIdentifier{}
+	satisfied := make(map[string]int)
+	for _, agent := range agents {
+		if role != nil && agent.AgentType != "" && agent.AgentType != *role {
+			continue
+		}
+		count := 0
+		for _, constraint := range constraints {
+			if constraint.MatchesAny(agent.Capabilities) {
+				count++
+			}
+		}
+		if count == 0 && len(constraints) > 0 {
+			continue
+		}
+		matched = append(matched, agent)
+		satisfied[agent.AgentID] = count
+	}
+
+	sort.SliceStable(matched, func(i, j int) bool {
+		return satisfied[matched[i].AgentID] > satisfied[matched[j].AgentID]
+	})
+	return matched, nil
+}
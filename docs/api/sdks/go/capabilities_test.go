@@ -0,0 +1,52 @@
+package a2aclient
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestFindAgents(t *testing.T) {
+	agents := []interface{}{
+		map[string]interface{}{"agent_id": "one", "agent_type": "coder", "capabilities": []interface{}{"search@1.0"}},
+		map[string]interface{}{"agent_id": "both", "agent_type": "coder", "capabilities": []interface{}{"search@2.1", "index@1.0"}},
+		map[string]interface{}{"agent_id": "old", "agent_type": "coder", "capabilities": []interface{}{"index@0.9"}},
+		map[string]interface{}{"agent_id": "analyst", "agent_type": "analyst", "capabilities": []interface{}{"search@2.0", "index@1.0"}},
+	}
+	var filter interface{}
+	client := memoryClient(func(_ context.Context, message *A2AMessage) (*A2AResponse, error) {
+		filter = message.Parameters["filter"]
+		return &A2AResponse{Success: true, Result: map[string]interface{}{"agents": agents}}, nil
+	}, nil)
+
+	ids := func(agents []AgentIdentifier) []string {
+		out := []string{}
+		for _, agent := range agents {
+			out = append(out, agent.AgentID)
+		}
+		return out
+	}
+	role := AgentRoleCoder
+
+	found, err := client.FindAgents(context.Background(), []string{"search", "index>=1.0"}, &role)
+	if err != nil {
+		t.Fatalf("FindAgents: %v", err)
+	}
+	// Most capabilities satisfied first; "old" fails the version constraint
+	if got, want := ids(found), []string{"both", "one"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("found %v, want %v", got, want)
+	}
+	wantFilter := map[string]interface{}{"role": "coder", "capabilities": []interface{}{"search", "index"}}
+	if !reflect.DeepEqual(filter, wantFilter) {
+		t.Errorf("filter %v, want %v: names only, without versions", filter, wantFilter)
+	}
+
+	found, err = client.FindAgents(context.Background(), []string{"deploy"}, nil)
+	if err != nil || found == nil || len(found) != 0 {
+		t.Errorf("found %v, err %v, want an empty slice", found, err)
+	}
+
+	if _, err := client.FindAgents(context.Background(), []string{"search >"}, nil); !HasCode(err, CodeValidation) {
+		t.Errorf("got %v, want VALIDATION_ERROR for a malformed constraint", err)
+	}
+}
@@ -0,0 +1,143 @@
+package a2aclient
+
+import (
+	"sync"
+	"time"
+)
+
+// CircuitState is the state of the client's circuit breaker
+type CircuitState string
+
+const (
+	CircuitClosed   CircuitState = "closed"
+	CircuitOpen     CircuitState = "open"
+	CircuitHalfOpen CircuitState = "half-open"
+)
+
+// CircuitBreakerConfig configures the client's circuit breaker. Only transport
+// failures (over HTTP or WebSocket) count; responses reporting an application
+// error do not.
+type CircuitBreakerConfig struct {
+	// FailureThreshold is the number of consecutive failures that opens the
+	// circuit (default 5)
+	FailureThreshold int `json:"failure_threshold"`
+	// Cooldown is how long the circuit stays open before letting probes
+	// through (default 30s)
+	Cooldown time.Duration `json:"cooldown"`
+	// HalfOpenProbes is the number of probes allowed while half-open; the
+	// circuit closes once they all succeed (default 1)
+	HalfOpenProbes int `json:"half_open_probes"`
+}
+
+// circuitBreaker implements the closed/open/half-open state machine
+type circuitBreaker struct {
+	mu        sync.Mutex
+	config    CircuitBreakerConfig
+	state     CircuitState
+	failures  int
+	openedAt  time.Time
+	probes    int // probes admitted in the current half-open period
+	successes int // probes that succeeded in the current half-open period
+}
+
+func newCircuitBreaker(config CircuitBreakerConfig) *circuitBreaker {
+	return &circuitBreaker{config: config, state: CircuitClosed}
+}
+
+// allow reports whether a request may be sent, moving an open circuit to
+// half-open once the cooldown has passed
+func (b *circuitBreaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case CircuitOpen:
+		if time.Since(b.openedAt) < b.config.Cooldown {
+			return false
+		}
+		b.state = CircuitHalfOpen
+		b.probes, b.successes = 0, 0
+		fallthrough
+	case CircuitHalfOpen:
+		if b.probes >= b.config.HalfOpenProbes {
+			return false
+		}
+		b.probes++
+		return true
+	default:
+		return true
+	}
+}
+
+// record updates the breaker with the outcome of an allowed request
+func (b *circuitBreaker) record(failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case CircuitHalfOpen:
+		if failed {
+			b.trip()
+			return
+		}
+		b.successes++
+		if b.successes >= b.config.HalfOpenProbes {
+			b.state = CircuitClosed
+			b.failures = 0
+		}
+	case CircuitClosed:
+		if !failed {
+			b.failures = 0
+			return
+		}
+		b.failures++
+		if b.failures >= b.config.FailureThreshold {
+			b.trip()
+		}
+	}
+}
+
+// release returns an allowed request's half-open probe slot without
+// recording an outcome
+func (b *circuitBreaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == CircuitHalfOpen && b.probes > 0 {
+		b.probes--
+	}
+}
+
+// trip opens the circuit; the caller holds mu
+func (b *circuitBreaker) trip() {
+	b.state = CircuitOpen
+	b.openedAt = time.Now()
+	b.failures = 0
+}
+
+// currentState returns the state, reporting an open circuit whose cooldown
+// has passed as half-open
+func (b *circuitBreaker) currentState() CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == CircuitOpen && time.Since(b.openedAt) >= b.config.Cooldown {
+		return CircuitHalfOpen
+	}
+	return b.state
+}
+
+// reset closes the circuit and clears its counters
+func (b *circuitBreaker) reset() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.state = CircuitClosed
+	b.failures, b.probes, b.successes = 0, 0, 0
+}
+
+// CircuitState returns the state of the client's circuit breaker. Clients
+// without a CircuitBreaker configured are always closed.
+func (c *A2AClient) CircuitState() CircuitState {
+	if c.circuitBreaker == nil {
+		return CircuitClosed
+	}
+	return c.circuitBreaker.currentState()
+}
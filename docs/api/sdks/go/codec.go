@@ -0,0 +1,108 @@
+package a2aclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/gorilla/websocket"
+)
+
+// Codec encodes messages and decodes responses on the wire. Codecs other
+// than JSONCodec typically encode through the JSON tags of the client's
+// types; see the msgpack subpackage for an example.
+type Codec interface {
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
+	// ContentType is the media type sent in the Content-Type and Accept
+	// headers of HTTP requests
+	ContentType() string
+}
+
+// PrefixUnmarshaler is implemented by codecs that can decode the complete
+// leading fields of a truncated value. Oversized WebSocket frames are read
+// only up to MaxFrameBytes; the client decodes that prefix to find the
+// request the frame answered and fail it. Codecs without it fall back to
+// Unmarshal, which usually fails on a truncated frame.
+type PrefixUnmarshaler interface {
+	UnmarshalPrefix(data []byte, v interface{}) error
+}
+
+// JSONCodec is the default Codec, using encoding/json
+type JSONCodec struct{}
+
+// Marshal encodes v as JSON
+func (JSONCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// Unmarshal decodes JSON data into v
+func (JSONCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+// ContentType returns "application/json"
+func (JSONCodec) ContentType() string {
+	return "application/json"
+}
+
+// usesJSON reports whether the configured codec is the default JSON one,
+// which is encoded into pooled buffers and decoded as a stream
+func (c *A2AClient) usesJSON() bool {
+	_, ok := c.config.Codec.(JSONCodec)
+	return ok
+}
+
+// encodeMessage encodes v with the configured codec into a pooled buffer.
+// The caller must pass the buffer to releaseBuffer once nothing refers to
+// its bytes any more.
+func (c *A2AClient) encodeMessage(v interface{}) (*bytes.Buffer, error) {
+	if c.usesJSON() {
+		return marshalPooled(v)
+	}
+	data, err := c.config.Codec.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	buf := bufferPool.Get().(*bytes.Buffer)
+	buf.Write(data)
+	return buf, nil
+}
+
+// decodeBody decodes a response body with the configured codec. Failures to
+// read the body are reported as transport errors.
+func (c *A2AClient) decodeBody(ctx context.Context, body io.Reader, v interface{}) error {
+	if !c.usesJSON() {
+		data, err := io.ReadAll(body)
+		if err != nil {
+			return transportError(ctx, "read response body", err)
+		}
+		if err := c.config.Codec.Unmarshal(data, v); err != nil {
+			return fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+		return nil
+	}
+
+	// Decode straight off the connection
+	if err := json.NewDecoder(body).Decode(v); err != nil {
+		var syntaxErr *json.SyntaxError
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &syntaxErr) || errors.As(err, &typeErr) || err == io.EOF {
+			return fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+		return transportError(ctx, "read response body", err)
+	}
+	return nil
+}
+
+// frameType returns the WebSocket message type for frames encoded with the
+// configured codec: text for JSON, binary otherwise
+func (c *A2AClient) frameType() int {
+	if c.usesJSON() {
+		return websocket.TextMessage
+	}
+	return websocket.BinaryMessage
+}
@@ -0,0 +1,35 @@
+package a2aclient
+
+import (
+	"bytes"
+	"compress/gzip"
+)
+
+// gzipBytes returns data gzip-compressed
+func gzipBytes(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write(data); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// requestBody returns the HTTP request body for a marshaled message and its
+// Content-Encoding, gzip-compressing it when it is larger than
+// CompressionThreshold. Responses need no handling here: the transport asks
+// for gzip and decompresses responses itself.
+func (c *A2AClient) requestBody(data []byte) ([]byte, string, error) {
+	threshold := c.config.CompressionThreshold
+	if threshold <= 0 || len(data) <= threshold {
+		return data, "", nil
+	}
+	compressed, err := gzipBytes(data)
+	if err != nil {
+		return nil, "", err
+	}
+	return compressed, "gzip", nil
+}
@@ -0,0 +1,57 @@
+package a2aclient
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCompressedRoundTrip(t *testing.T) {
+	payload := strings.Repeat("compressible ", 200)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := io.Reader(r.Body)
+		large := r.Header.Get("Content-Encoding") == "gzip"
+		if large {
+			reader, err := gzip.NewReader(r.Body)
+			if err != nil {
+				t.Errorf("request body is not gzip: %v", err)
+				return
+			}
+			body = reader
+		}
+		var message A2AMessage
+		if err := json.NewDecoder(body).Decode(&message); err != nil {
+			t.Errorf("decode request: %v", err)
+			return
+		}
+		if got := message.Parameters["payload"] != nil; got != large {
+			t.Errorf("payload sent %v, Content-Encoding %q", got, r.Header.Get("Content-Encoding"))
+		}
+
+		if !strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+			t.Errorf("Accept-Encoding %q, want gzip", r.Header.Get("Accept-Encoding"))
+		}
+		w.Header().Set("Content-Encoding", "gzip")
+		writer := gzip.NewWriter(w)
+		json.NewEncoder(writer).Encode(echoResult(&message))
+		writer.Close()
+	}))
+	defer server.Close()
+
+	client := NewA2AClient(&A2AClientConfig{BaseURL: server.URL, CompressionThreshold: 512})
+	for _, params := range []map[string]interface{}{nil, {"payload": payload}} {
+		response, err := client.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowMemoryUsage, params))
+		if err != nil {
+			t.Fatalf("SendMessage: %v", err)
+		}
+		result, _ := response.Result.(map[string]interface{})
+		if params != nil && (result == nil || result["payload"] != payload) {
+			t.Errorf("response result lost the payload: %v", response.Result)
+		}
+	}
+}
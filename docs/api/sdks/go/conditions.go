@@ -0,0 +1,283 @@
+package a2aclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Client-side Condition Evaluation
+
+// MatchAgent reports whether agent satisfies every condition. Conditions are
+// evaluated by type:
+//
+//   - "capability": Value is a capability constraint such as "pytorch" or
+//     "pytorch>=2.0". equals and not_equals test whether any capability
+//     satisfies it, contains whether any capability name contains Value,
+//     and greater_than and less_than compare the number of capabilities
+//     with a numeric Value.
+//   - "status" and "location": equals and not_equals compare the agent's
+//     Status or Location with Value ignoring case, contains tests for
+//     Value as a substring.
+//   - "resource": Value maps resource names to numbers, each compared with
+//     the agent's Resources entry by equals, not_equals, greater_than or
+//     less_than; for contains, Value names the resources, as a string or a
+//     list, the agent must report. A missing resource never matches.
+//
+// "custom" conditions, unknown types and unknown operators do not match.
+func MatchAgent(agent AgentIdentifier, conditions []AgentCondition) bool {
+	for _, condition := range conditions {
+		if !matchCondition(agent, condition) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchCondition(agent AgentIdentifier, condition AgentCondition) bool {
+	switch condition.Type {
+	case "capability":
+		return matchCapabilityCondition(agent.Capabilities, condition)
+	case "status":
+		return matchStringCondition(agent.Status, condition)
+	case "location":
+		return matchStringCondition(agent.Location, condition)
+	case "resource":
+		return matchResourceCondition(agent.Resources, condition)
+	}
+	return false
+}
+
+func matchCapabilityCondition(capabilities []string, condition AgentCondition) bool {
+	switch condition.Operator {
+	case "greater_than", "less_than":
+		want, ok := conditionNumber(condition.Value)
+		return ok && compareNumbers(float64(len(capabilities)), condition.Operator, want)
+	}
+
+	value, ok := condition.Value.(string)
+	if !ok {
+		return false
+	}
+	switch condition.Operator {
+	case "equals", "not_equals":
+		constraint, err := ParseCapabilityConstraint(value)
+		if err != nil {
+			return false
+		}
+		return constraint.MatchesAny(capabilities) == (condition.Operator == "equals")
+	case "contains":
+		for _, capability := range capabilities {
+			if strings.Contains(strings.ToLower(capability), strings.ToLower(value)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func matchStringCondition(have string, condition AgentCondition) bool {
+	value, ok := condition.Value.(string)
+	if !ok {
+		return false
+	}
+	switch condition.Operator {
+	case "equals":
+		return strings.EqualFold(have, value)
+	case "not_equals":
+		return !strings.EqualFold(have, value)
+	case "contains":
+		return strings.Contains(strings.ToLower(have), strings.ToLower(value))
+	}
+	return false
+}
+
+func matchResourceCondition(resources map[string]float64, condition AgentCondition) bool {
+	if condition.Operator == "contains" {
+		var names []string
+		switch value := condition.Value.(type) {
+		case string:
+			names = []string{value}
+		case []string:
+			names = value
+		case []interface{}:
+			for _, item := range value {
+				name, ok := item.(string)
+				if !ok {
+					return false
+				}
+				names = append(names, name)
+			}
+		default:
+			return false
+		}
+		for _, name := range names {
+			if _, ok := resources[name]; !ok {
+				return false
+			}
+		}
+		return len(names) > 0
+	}
+
+	var thresholds map[string]interface{}
+	switch value := condition.Value.(type) {
+	case map[string]interface{}:
+		thresholds = value
+	case map[string]float64:
+		thresholds = make(map[string]interface{}, len(value))
+		for name, threshold := range value {
+			thresholds[name] = threshold
+		}
+	default:
+		return false
+	}
+	if len(thresholds) == 0 {
+		return false
+	}
+	for name, threshold := range thresholds {
+		want, ok := conditionNumber(threshold)
+		if !ok {
+			return false
+		}
+		have, ok := resources[name]
+		if !ok || !compareNumbers(have, condition.Operator, want) {
+			return false
+		}
+	}
+	return true
+}
+
+// compareNumbers applies a numeric condition operator
+func compareNumbers(have float64, operator string, want float64) bool {
+	switch operator {
+	case "equals":
+		return have == want
+	case "not_equals":
+		return have != want
+	case "greater_than":
+		return have > want
+	case "less_than":
+		return have < want
+	}
+	return false
+}
+
+// conditionNumber converts a condition value decoded from JSON or set in
+// code to a float64
+func conditionNumber(value interface{}) (float64, bool) {
+	switch n := value.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint:
+		return float64(n), true
+	case uint32:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	}
+	return 0, false
+}
+
+// ResolveConditionalTarget lists agents and returns the IDs of those
+// matching every condition of target, evaluated client-side by MatchAgent.
+// When none match, target.Fallback is resolved instead: single and multiple
+// targets give their agent IDs, group targets go through
+// ResolveGroupTarget, broadcast targets list agents with their filter, and
+// conditional targets are resolved recursively. Without a fallback an empty
+// slice is returned. Conditions that cannot be evaluated client-side, such
+// as "custom" ones, fail with VALIDATION_ERROR so the message can be left
+// to the server.
+func (c *A2AClient) ResolveConditionalTarget(ctx context.Context, target ConditionalTarget) ([]string, error) {
+	for i, condition := range target.Conditions {
+		if err := checkLocalCondition(condition); err != nil {
+			return nil, NewA2AClientError(CodeValidation, fmt.Sprintf("Condition %d %v", i, err), condition)
+		}
+	}
+
+	agents, err := c.listAgentIdentifiers(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	matched := []string{}
+	for _, agent := range agents {
+		if MatchAgent(agent, target.Conditions) {
+			matched = append(matched, agent.AgentID)
+		}
+	}
+	if len(matched) > 0 || target.Fallback == nil {
+		return matched, nil
+	}
+	return c.resolveTargetIDs(ctx, *target.Fallback)
+}
+
+// checkLocalCondition reports why a condition cannot be evaluated by
+// MatchAgent, if it cannot
+func checkLocalCondition(condition AgentCondition) error {
+	switch condition.Type {
+	case "capability", "status", "location", "resource":
+	case "custom":
+		return errors.New("of type custom cannot be evaluated client-side")
+	default:
+		return fmt.Errorf("has unknown type %q", condition.Type)
+	}
+	switch condition.Operator {
+	case "equals", "not_equals", "contains", "greater_than", "less_than":
+	default:
+		return fmt.Errorf("has unknown operator %q", condition.Operator)
+	}
+	return nil
+}
+
+// resolveTargetIDs returns the agent IDs a fallback target addresses
+func (c *A2AClient) resolveTargetIDs(ctx context.Context, target AgentTarget) ([]string, error) {
+	switch {
+	case target.SingleTarget != nil:
+		return []string{target.SingleTarget.AgentID}, nil
+	case target.MultipleTargets != nil:
+		return append([]string{}, target.MultipleTargets.AgentIDs...), nil
+	case target.GroupTarget != nil:
+		agents, err := c.ResolveGroupTarget(ctx, *target.GroupTarget)
+		if err != nil {
+			return nil, err
+		}
+		return agentIDs(agents), nil
+	case target.BroadcastTarget != nil:
+		agents, err := c.listAgentIdentifiers(ctx, target.BroadcastTarget.Filter)
+		if err != nil {
+			return nil, err
+		}
+		return agentIDs(agents), nil
+	case target.ConditionalTarget != nil:
+		return c.ResolveConditionalTarget(ctx, *target.ConditionalTarget)
+	}
+	return []string{}, nil
+}
+
+// listAgentIdentifiers lists the agents matching filter
+func (c *A2AClient) listAgentIdentifiers(ctx context.Context, filter *AgentFilter) ([]AgentIdentifier, error) {
+	response, err := c.ListAgents(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if err := responseError(response); err != nil {
+		return nil, err
+	}
+	return decodeAgents(response.Result)
+}
+
+func agentIDs(agents []AgentIdentifier) []string {
+	ids := make([]string, 0, len(agents))
+	for _, agent := range agents {
+		ids = append(ids, agent.AgentID)
+	}
+	return ids
+}
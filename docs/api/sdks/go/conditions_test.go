@@ -0,0 +1,119 @@
+package a2aclient
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestMatchAgentOperators(t *testing.T) {
+	agent := AgentIdentifier{
+		AgentID:      "agent-1",
+		Capabilities: []string{"pytorch@2.1", "cuda"},
+		Status:       "Active",
+		Location:     "eu-west-1",
+		Resources:    map[string]float64{"gpu": 2, "memory": 16},
+	}
+
+	tests := []struct {
+		typ      string
+		operator string
+		value    interface{}
+		want     bool
+	}{
+		{"capability", "equals", "pytorch>=2.0", true},
+		{"capability", "equals", "pytorch>=3.0", false},
+		{"capability", "equals", "cuda", true},
+		{"capability", "not_equals", "tensorflow", true},
+		{"capability", "not_equals", "cuda", false},
+		{"capability", "contains", "TORCH", true},
+		{"capability", "contains", "tensor", false},
+		{"capability", "greater_than", 1, true},
+		{"capability", "greater_than", 2.0, false},
+		{"capability", "less_than", 3, true},
+		{"capability", "less_than", 2, false},
+		{"capability", "equals", 2, false},
+
+		{"status", "equals", "active", true},
+		{"status", "equals", "idle", false},
+		{"status", "not_equals", "idle", true},
+		{"status", "not_equals", "ACTIVE", false},
+		{"status", "contains", "act", true},
+		{"status", "contains", "busy", false},
+		{"status", "greater_than", "a", false},
+
+		{"location", "equals", "EU-WEST-1", true},
+		{"location", "not_equals", "eu-west-1", false},
+		{"location", "contains", "west", true},
+		{"location", "contains", "us-", false},
+
+		{"resource", "equals", map[string]interface{}{"gpu": 2.0}, true},
+		{"resource", "equals", map[string]float64{"gpu": 1}, false},
+		{"resource", "not_equals", map[string]interface{}{"gpu": 1}, true},
+		{"resource", "greater_than", map[string]interface{}{"gpu": 1, "memory": 8}, true},
+		{"resource", "greater_than", map[string]interface{}{"gpu": 1, "memory": 32}, false},
+		{"resource", "less_than", map[string]interface{}{"memory": 32}, true},
+		{"resource", "less_than", map[string]interface{}{"disk": 100}, false},
+		{"resource", "less_than", map[string]interface{}{}, false},
+		{"resource", "contains", "gpu", true},
+		{"resource", "contains", []string{"gpu", "memory"}, true},
+		{"resource", "contains", []interface{}{"gpu", "disk"}, false},
+		{"resource", "contains", []string{}, false},
+
+		{"custom", "equals", "anything", false},
+		{"region", "equals", "eu-west-1", false},
+		{"status", "matches", "active", false},
+	}
+	for _, tt := range tests {
+		condition := AgentCondition{Type: tt.typ, Operator: tt.operator, Value: tt.value}
+		if got := MatchAgent(agent, []AgentCondition{condition}); got != tt.want {
+			t.Errorf("%s %s %v = %v, want %v", tt.typ, tt.operator, tt.value, got, tt.want)
+		}
+	}
+
+	both := []AgentCondition{
+		{Type: "status", Operator: "equals", Value: "active"},
+		{Type: "location", Operator: "contains", Value: "us-"},
+	}
+	if MatchAgent(agent, both) {
+		t.Error("matched with one of two conditions failing")
+	}
+	if !MatchAgent(agent, nil) {
+		t.Error("no conditions did not match")
+	}
+}
+
+func TestResolveConditionalTarget(t *testing.T) {
+	client := memoryClient(func(context.Context, *A2AMessage) (*A2AResponse, error) {
+		return &A2AResponse{Success: true, Result: []interface{}{
+			map[string]interface{}{"agent_id": "a", "status": "active"},
+			map[string]interface{}{"agent_id": "b", "status": "idle"},
+			map[string]interface{}{"agent_id": "c", "status": "active"},
+		}}, nil
+	}, nil)
+	ctx := context.Background()
+	condition := func(status string) []AgentCondition {
+		return []AgentCondition{{Type: "status", Operator: "equals", Value: status}}
+	}
+
+	ids, err := client.ResolveConditionalTarget(ctx, ConditionalTarget{Conditions: condition("active")})
+	if err != nil || !reflect.DeepEqual(ids, []string{"a", "c"}) {
+		t.Errorf("got %v, %v, want a and c", ids, err)
+	}
+
+	fallback := Utils.SingleTarget("standby")
+	ids, err = client.ResolveConditionalTarget(ctx, ConditionalTarget{Conditions: condition("busy"), Fallback: &fallback})
+	if err != nil || !reflect.DeepEqual(ids, []string{"standby"}) {
+		t.Errorf("got %v, %v, want the fallback agent", ids, err)
+	}
+
+	ids, err = client.ResolveConditionalTarget(ctx, ConditionalTarget{Conditions: condition("busy")})
+	if err != nil || ids == nil || len(ids) != 0 {
+		t.Errorf("got %v, %v, want an empty slice", ids, err)
+	}
+
+	custom := []AgentCondition{{Type: "custom", Operator: "equals", Value: "x"}}
+	if _, err := client.ResolveConditionalTarget(ctx, ConditionalTarget{Conditions: custom}); !HasCode(err, CodeValidation) {
+		t.Errorf("custom condition: got %v, want VALIDATION_ERROR", err)
+	}
+}
@@ -0,0 +1,133 @@
+package a2aclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+)
+
+// LoadConfig reads a JSON client configuration from path and applies the
+// same defaults as NewA2AClient. Durations are given in nanoseconds, as
+// encoding/json represents time.Duration. Syntax and type errors report the
+// line and column they occurred at. The result is not validated; call
+// Validate for that.
+func LoadConfig(path string) (*A2AClientConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var config A2AClientConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		var syntaxErr *json.SyntaxError
+		var typeErr *json.UnmarshalTypeError
+		switch {
+		case errors.As(err, &syntaxErr):
+			line, col := position(data, syntaxErr.Offset)
+			return nil, fmt.Errorf("failed to parse config file %s:%d:%d: %w", path, line, col, err)
+		case errors.As(err, &typeErr):
+			line, col := position(data, typeErr.Offset)
+			return nil, fmt.Errorf("failed to parse config file %s:%d:%d: %w", path, line, col, err)
+		}
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	config.applyDefaults()
+	return &config, nil
+}
+
+// Validate checks that the configuration can be used to create a client,
+// returning every problem found joined into one error
+func (config *A2AClientConfig) Validate() error {
+	var errs []error
+	invalid := func(format string, args ...interface{}) {
+		errs = append(errs, NewA2AClientError(CodeValidation, fmt.Sprintf(format, args...), nil))
+	}
+
+	baseURLs := config.BaseURLs
+	if len(baseURLs) == 0 && config.BaseURL != "" {
+		baseURLs = []string{config.BaseURL}
+	}
+	if len(baseURLs) == 0 {
+		invalid("Base URL is required")
+	}
+	for _, baseURL := range baseURLs {
+		if u, err := url.Parse(baseURL); err != nil || u.Scheme == "" || u.Host == "" {
+			invalid("Base URL %q is not an absolute URL", baseURL)
+		} else if u.Scheme != "http" && u.Scheme != "https" {
+			invalid("Base URL scheme must be http or https, got %q", u.Scheme)
+		}
+	}
+
+	switch config.EndpointStrategy {
+	case "", EndpointPrimaryWithFailover, EndpointRoundRobin, EndpointRandom:
+	default:
+		invalid("Unknown endpoint strategy %q", config.EndpointStrategy)
+	}
+
+	if config.ProxyURL != "" {
+		if _, err := parseProxyURL(config.ProxyURL); err != nil {
+			invalid("%s", err.Error())
+		}
+	}
+
+	if config.Timeout <= 0 {
+		invalid("Timeout must be positive")
+	}
+
+	if config.CompressionThreshold < 0 {
+		invalid("Compression threshold must not be negative")
+	}
+	if config.MaxInFlight < 0 {
+		invalid("Max in-flight requests must not be negative")
+	}
+	if config.ResponseCache != nil && config.ResponseCache.TTL < 0 {
+		invalid("Response cache TTL must not be negative")
+	}
+
+	if config.RetryPolicy != nil {
+		if config.RetryPolicy.MaxRetries < 0 {
+			invalid("Retry policy max retries must not be negative")
+		}
+		if config.RetryPolicy.TotalTimeout < 0 {
+			invalid("Retry policy total timeout must not be negative")
+		}
+		switch config.RetryPolicy.Jitter {
+		case "", "none", "full", "equal":
+		default:
+			invalid("Unknown retry jitter %q", config.RetryPolicy.Jitter)
+		}
+	}
+
+	if config.Certificate != nil {
+		files := []struct{ name, path string }{
+			{"certificate file", config.Certificate.CertFile},
+			{"key file", config.Certificate.KeyFile},
+			{"CA file", config.Certificate.CAFile},
+		}
+		for _, file := range files {
+			if file.path == "" {
+				continue
+			}
+			if _, err := os.Stat(file.path); err != nil {
+				invalid("Cannot access %s %s: %v", file.name, file.path, err)
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// position converts a byte offset in data to a 1-based line and column
+func position(data []byte, offset int64) (line, col int) {
+	if offset > int64(len(data)) {
+		offset = int64(len(data))
+	}
+	before := data[:offset]
+	line = bytes.Count(before, []byte("\n")) + 1
+	col = int(offset) - bytes.LastIndexByte(before, '\n')
+	return line, col
+}
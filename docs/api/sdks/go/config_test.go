@@ -0,0 +1,63 @@
+package a2aclient
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoadConfigRoundTrip(t *testing.T) {
+	saved := &A2AClientConfig{
+		BaseURLs:         []string{"https://a.example", "https://b.example"},
+		EndpointStrategy: EndpointRoundRobin,
+		APIKey:           "key",
+		Timeout:          5 * time.Second,
+		WebSocketEnabled: true,
+		RetryPolicy: &RetryPolicy{
+			MaxRetries:      2,
+			BackoffStrategy: "exponential",
+			BaseDelay:       100 * time.Millisecond,
+			MaxDelay:        time.Second,
+			RetryableErrors: []string{CodeRateLimited},
+			Jitter:          "full",
+		},
+		Certificate: &A2ACertificate{CertFile: "testdata/client-pkcs8.crt", KeyFile: "testdata/client-pkcs8.key", Passphrase: "secret", WatchCertificates: true},
+	}
+	saved.applyDefaults()
+
+	path := filepath.Join(t.TempDir(), "config.json")
+	data, err := json.MarshalIndent(saved, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+	if err := loaded.Validate(); err != nil {
+		t.Errorf("Validate: %v", err)
+	}
+	if !reflect.DeepEqual(loaded, saved) {
+		reloaded, _ := json.Marshal(loaded)
+		t.Errorf("loaded config differs:\n got %s\nwant %s", reloaded, data)
+	}
+}
+
+func TestLoadConfigReportsErrorPosition(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte("{\n  \"base_url\": \"https://a.example\",\n  \"timeout\": \"5s\"\n}\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	_, err := LoadConfig(path)
+	if err == nil || !strings.Contains(err.Error(), "config.json:3:") {
+		t.Errorf("got %v, want the error located on line 3", err)
+	}
+}
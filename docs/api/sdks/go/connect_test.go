@@ -0,0 +1,81 @@
+package a2aclient
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestConnectRetriesOnlyRetryableFailures(t *testing.T) {
+	status := func(code int) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(code)
+		}))
+	}
+	refused := httptest.NewServer(http.NotFoundHandler())
+	refused.Close()
+
+	tests := []struct {
+		name     string
+		baseURL  func(t *testing.T) string
+		code     string
+		attempts int
+	}{
+		{"unauthorized", func(t *testing.T) string {
+			s := status(http.StatusUnauthorized)
+			t.Cleanup(s.Close)
+			return s.URL
+		}, CodeAuthFailed, 1},
+		{"forbidden", func(t *testing.T) string {
+			s := status(http.StatusForbidden)
+			t.Cleanup(s.Close)
+			return s.URL
+		}, CodeAuthFailed, 1},
+		{"TLS failure", func(t *testing.T) string {
+			s := httptest.NewUnstartedServer(http.NotFoundHandler())
+			s.Config.ErrorLog = log.New(io.Discard, "", 0)
+			s.StartTLS()
+			t.Cleanup(s.Close)
+			return s.URL
+		}, "", 1},
+		{"invalid URL", func(*testing.T) string { return "ftp://a2a.test" }, CodeValidation, 1},
+		{"server error", func(t *testing.T) string {
+			s := status(http.StatusServiceUnavailable)
+			t.Cleanup(s.Close)
+			return s.URL
+		}, CodeServerError, 1},
+		{"connection refused", func(*testing.T) string { return refused.URL }, CodeConnectionFailed, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			client := NewA2AClient(&A2AClientConfig{
+				BaseURL:          tt.baseURL(t),
+				WebSocketEnabled: true,
+				RetryPolicy:      fastRetries(2),
+				OnConnectionEvent: func(event ConnectionEvent) {
+					if event.Type == ConnectionEventAttempt {
+						attempts++
+					}
+				},
+			})
+
+			err := client.Connect(context.Background())
+			if err == nil {
+				t.Fatal("Connect succeeded")
+			}
+			if tt.code != "" && !HasCode(err, tt.code) {
+				t.Errorf("got %v, want %s", err, tt.code)
+			}
+			if tt.code == "" && client.isRetryableError(err, DefaultRetryableErrors) {
+				t.Errorf("got retryable %v", err)
+			}
+			if attempts != tt.attempts {
+				t.Errorf("%d attempts, want %d", attempts, tt.attempts)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package a2aclient
+
+import (
+	"context"
+	"fmt"
+)
+
+// ConsensusConfig configures a DAA consensus vote
+type ConsensusConfig struct {
+	ConsensusType       string             // "unanimous", "majority" (default), "weighted"
+	Weights             map[string]float64 // vote weight by agent ID; required for "weighted"
+	MinimumParticipants int                // 0 leaves the minimum to the server
+	VotingTimeout       int                // seconds; 0 leaves the timeout to the server
+}
+
+// DAAConsensus asks the DAA coordinators to vote on proposal. In weighted
+// mode each agent's vote counts by its weight, and agents without a weight
+// do not count.
+func (c *A2AClient) DAAConsensus(ctx context.Context, proposal interface{}, config ConsensusConfig) (*A2AResponse, error) {
+	consensusType := config.ConsensusType
+	if consensusType == "" {
+		consensusType = "majority"
+	}
+	switch consensusType {
+	case "unanimous", "majority":
+	case "weighted":
+		if len(config.Weights) == 0 {
+			return nil, NewA2AClientError(CodeValidation, "Weighted consensus requires agent weights", nil)
+		}
+	default:
+		return nil, NewA2AClientError(CodeValidation, fmt.Sprintf("Unknown consensus type %q", consensusType), nil)
+	}
+	for agentID, weight := range config.Weights {
+		if weight < 0 {
+			return nil, NewA2AClientError(CodeValidation, fmt.Sprintf("Weight for agent %q must not be negative", agentID), weight)
+		}
+	}
+
+	consensus := &ConsensusCoordination{
+		Mode:          "consensus",
+		ConsensusType: consensusType,
+	}
+	if config.MinimumParticipants > 0 {
+		consensus.MinimumParticipants = intPtr(config.MinimumParticipants)
+	}
+	if config.VotingTimeout > 0 {
+		consensus.VotingTimeout = intPtr(config.VotingTimeout)
+	}
+
+	message := &A2AMessage{
+		Target: AgentTarget{
+			GroupTarget: &GroupTarget{
+				Type: "group",
+				Role: AgentRoleDAACoordinator,
+			},
+		},
+		ToolName: MCPToolClaudeFlowDAAConsensus,
+		Parameters: map[string]interface{}{
+			"proposal": proposal,
+		},
+		Coordination: CoordinationMode{ConsensusCoordination: consensus},
+	}
+	if consensusType == "weighted" {
+		message.Parameters["weights"] = config.Weights
+	}
+
+	return c.SendMessage(ctx, message)
+}
@@ -0,0 +1,54 @@
+package a2aclient
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestDAAConsensus(t *testing.T) {
+	var sent *A2AMessage
+	client := memoryClient(func(_ context.Context, message *A2AMessage) (*A2AResponse, error) {
+		sent = message
+		return echoResult(message), nil
+	}, nil)
+	ctx := context.Background()
+
+	invalid := []ConsensusConfig{
+		{ConsensusType: "plurality"},
+		{ConsensusType: "weighted"},
+		{ConsensusType: "majority", Weights: map[string]float64{"a": -1}},
+	}
+	for _, config := range invalid {
+		if _, err := client.DAAConsensus(ctx, "p", config); !HasCode(err, CodeValidation) {
+			t.Errorf("%+v: got %v, want VALIDATION_ERROR", config, err)
+		}
+	}
+
+	if _, err := client.DAAConsensus(ctx, "deploy", ConsensusConfig{}); err != nil {
+		t.Fatalf("DAAConsensus: %v", err)
+	}
+	consensus := sent.Coordination.ConsensusCoordination
+	if consensus == nil || consensus.ConsensusType != "majority" || consensus.MinimumParticipants != nil {
+		t.Errorf("coordination %+v, want majority with the server's minimum", consensus)
+	}
+	if _, ok := sent.Parameters["weights"]; ok {
+		t.Errorf("params %v, want weights only in weighted mode", sent.Parameters)
+	}
+
+	weights := map[string]float64{"a": 2, "b": 0.5}
+	_, err := client.DAAConsensus(ctx, "deploy", ConsensusConfig{ConsensusType: "weighted", Weights: weights, MinimumParticipants: 2, VotingTimeout: 30})
+	if err != nil {
+		t.Fatalf("weighted DAAConsensus: %v", err)
+	}
+	consensus = sent.Coordination.ConsensusCoordination
+	if consensus.ConsensusType != "weighted" || *consensus.MinimumParticipants != 2 || *consensus.VotingTimeout != 30 {
+		t.Errorf("coordination %+v, want weighted with minimum 2 and timeout 30", consensus)
+	}
+	if want := map[string]interface{}{"a": 2.0, "b": 0.5}; !reflect.DeepEqual(sent.Parameters["weights"], want) {
+		t.Errorf("weights %v, want %v", sent.Parameters["weights"], want)
+	}
+	if sent.Target.GroupTarget == nil || sent.Target.GroupTarget.Role != AgentRoleDAACoordinator {
+		t.Errorf("target %+v, want the DAA coordinators", sent.Target)
+	}
+}
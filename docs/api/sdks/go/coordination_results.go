@@ -0,0 +1,266 @@
+package a2aclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Coordination Result Types
+
+// CoordinationPerf is the phase breakdown of a coordinated operation as
+// reported in A2AResponse.Performance
+type CoordinationPerf struct {
+	TargetingTime    time.Duration
+	DispatchTime     time.Duration
+	AggregationTime  time.Duration
+	TotalTime        time.Duration
+	ParticipantCount int
+}
+
+// AgentTime returns the part of TotalTime not spent in targeting, dispatch,
+// or aggregation, i.e. the time agents spent processing
+func (p *CoordinationPerf) AgentTime() time.Duration {
+	agentTime := p.TotalTime - p.TargetingTime - p.DispatchTime - p.AggregationTime
+	if agentTime < 0 {
+		return 0
+	}
+	return agentTime
+}
+
+// coordinationPerfWire mirrors the wire format, where timings are milliseconds
+type coordinationPerfWire struct {
+	TargetingTime    *float64 `json:"targeting_time"`
+	DispatchTime     *float64 `json:"dispatch_time"`
+	AggregationTime  *float64 `json:"aggregation_time"`
+	TotalTime        *float64 `json:"total_time"`
+	ParticipantCount int      `json:"participant_count"`
+}
+
+// CoordinationPerf decodes the coordination phase timings from the response's
+// Performance map. TotalTime falls back to the sum of the phases when the
+// server does not report it.
+func (r *A2AResponse) CoordinationPerf() (*CoordinationPerf, error) {
+	if len(r.Performance) == 0 {
+		return nil, fmt.Errorf("response %s has no performance data", r.MessageID)
+	}
+
+	var wire coordinationPerfWire
+	if err := decodeMap(r.Performance, &wire); err != nil {
+		return nil, fmt.Errorf("failed to decode coordination performance: %w", err)
+	}
+	if wire.TargetingTime == nil && wire.DispatchTime == nil && wire.AggregationTime == nil && wire.TotalTime == nil {
+		return nil, fmt.Errorf("response %s has no coordination timings", r.MessageID)
+	}
+
+	perf := &CoordinationPerf{
+		TargetingTime:    millisToDuration(wire.TargetingTime),
+		DispatchTime:     millisToDuration(wire.DispatchTime),
+		AggregationTime:  millisToDuration(wire.AggregationTime),
+		TotalTime:        millisToDuration(wire.TotalTime),
+		ParticipantCount: wire.ParticipantCount,
+	}
+	if wire.TotalTime == nil {
+		perf.TotalTime = perf.TargetingTime + perf.DispatchTime + perf.AggregationTime
+	}
+
+	return perf, nil
+}
+
+// decodeMap converts a generic JSON value into the given typed destination
+func decodeMap(value interface{}, dest interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, dest)
+}
+
+// millisToDuration converts an optional millisecond value into a duration
+func millisToDuration(ms *float64) time.Duration {
+	if ms == nil {
+		return 0
+	}
+	return time.Duration(*ms * float64(time.Millisecond))
+}
+
+// RaceResult describes the outcome of a MultipleTargets message sent with
+// CoordinationMode "race"
+type RaceResult struct {
+	Winner         AgentIdentifier
+	WinnerLatency  time.Duration
+	Losers         []AgentIdentifier
+	LoserLatencies map[string]time.Duration // keyed by agent ID, only for losers that responded
+}
+
+// Margin returns how far ahead of the fastest responding loser the winner
+// finished, or zero if no loser responded
+func (r *RaceResult) Margin() time.Duration {
+	var fastest time.Duration
+	for _, latency := range r.LoserLatencies {
+		if fastest == 0 || latency < fastest {
+			fastest = latency
+		}
+	}
+	if fastest == 0 {
+		return 0
+	}
+	return fastest - r.WinnerLatency
+}
+
+// raceLoserWire is a losing agent with its latency in milliseconds
+type raceLoserWire struct {
+	AgentIdentifier
+	Latency *float64 `json:"latency,omitempty"`
+}
+
+// raceResultWire mirrors the "race" entry of the Performance map
+type raceResultWire struct {
+	Winner        *AgentIdentifier `json:"winner,omitempty"`
+	WinnerLatency *float64         `json:"winner_latency,omitempty"`
+	Losers        []raceLoserWire  `json:"losers,omitempty"`
+}
+
+// RaceResult decodes the race outcome of a "race" coordinated response. The
+// winner defaults to the response source and its latency to the reported
+// processing time when the server omits them.
+func (r *A2AResponse) RaceResult() (*RaceResult, error) {
+	raw, ok := r.Performance["race"]
+	if !ok {
+		return nil, fmt.Errorf("response %s has no race result", r.MessageID)
+	}
+
+	var wire raceResultWire
+	if err := decodeMap(raw, &wire); err != nil {
+		return nil, fmt.Errorf("failed to decode race result: %w", err)
+	}
+
+	result := &RaceResult{
+		Winner:         r.Source,
+		WinnerLatency:  millisToDuration(wire.WinnerLatency),
+		LoserLatencies: make(map[string]time.Duration),
+	}
+	if wire.Winner != nil {
+		result.Winner = *wire.Winner
+	}
+	if wire.WinnerLatency == nil {
+		result.WinnerLatency = millisToDuration(r.Metadata.ProcessingTime)
+	}
+	for _, loser := range wire.Losers {
+		result.Losers = append(result.Losers, loser.AgentIdentifier)
+		if loser.Latency != nil {
+			result.LoserLatencies[loser.AgentID] = millisToDuration(loser.Latency)
+		}
+	}
+
+	return result, nil
+}
+
+// Pipeline stage states reported in a PipelineTrace
+const (
+	StageStatusPending   = "pending"
+	StageStatusRunning   = "running"
+	StageStatusCompleted = "completed"
+	StageStatusFailed    = "failed"
+	StageStatusRetried   = "retried" // failed at least once, then retried under the "retry" strategy
+	StageStatusSkipped   = "skipped" // failed and passed over under the "skip" strategy
+	StageStatusAborted   = "aborted" // never ran because an earlier stage failed under "abort"
+)
+
+// StageTrace records what happened in one pipeline stage
+type StageTrace struct {
+	Name            string           `json:"name"`
+	Status          string           `json:"status"`
+	Agent           *AgentIdentifier `json:"agent,omitempty"`
+	Input           interface{}      `json:"input,omitempty"`
+	Output          interface{}      `json:"output,omitempty"`
+	InputTransform  string           `json:"input_transform,omitempty"`
+	OutputTransform string           `json:"output_transform,omitempty"`
+	Attempts        int              `json:"attempts,omitempty"`
+	DurationMs      float64          `json:"duration,omitempty"`
+	Error           *A2AError        `json:"error,omitempty"`
+}
+
+// Duration returns how long the stage ran
+func (s *StageTrace) Duration() time.Duration {
+	return millisToDuration(&s.DurationMs)
+}
+
+// PipelineTrace is the per-stage execution trace of a pipeline coordinated
+// message, in stage order
+type PipelineTrace struct {
+	FailureStrategy string       `json:"failure_strategy,omitempty"`
+	Stages          []StageTrace `json:"stages"`
+}
+
+// Stage returns the trace of the named stage
+func (t *PipelineTrace) Stage(name string) (*StageTrace, bool) {
+	for i := range t.Stages {
+		if t.Stages[i].Name == name {
+			return &t.Stages[i], true
+		}
+	}
+	return nil, false
+}
+
+// Duration returns the summed duration of all stages
+func (t *PipelineTrace) Duration() time.Duration {
+	var total time.Duration
+	for i := range t.Stages {
+		total += t.Stages[i].Duration()
+	}
+	return total
+}
+
+// Accumulate merges a streamed pipeline response into the trace. A response
+// carrying a full "pipeline_trace" replaces the trace; one carrying a single
+// "stage_trace" updates that stage in place, or appends it if new. Responses
+// with neither are ignored.
+func (t *PipelineTrace) Accumulate(response *A2AResponse) error {
+	result, ok := response.Result.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if raw, ok := result["pipeline_trace"]; ok {
+		var full PipelineTrace
+		if err := decodeMap(raw, &full); err != nil {
+			return fmt.Errorf("failed to decode pipeline trace: %w", err)
+		}
+		*t = full
+		return nil
+	}
+
+	raw, ok := result["stage_trace"]
+	if !ok {
+		return nil
+	}
+	var stage StageTrace
+	if err := decodeMap(raw, &stage); err != nil {
+		return fmt.Errorf("failed to decode stage trace: %w", err)
+	}
+	if existing, ok := t.Stage(stage.Name); ok {
+		*existing = stage
+	} else {
+		t.Stages = append(t.Stages, stage)
+	}
+	return nil
+}
+
+// PipelineTrace decodes the execution trace of a pipeline coordinated
+// response from the "pipeline_trace" field of its result
+func (r *A2AResponse) PipelineTrace() (*PipelineTrace, error) {
+	result, ok := r.Result.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("response %s has no pipeline trace", r.MessageID)
+	}
+	if _, ok := result["pipeline_trace"]; !ok {
+		return nil, fmt.Errorf("response %s has no pipeline trace", r.MessageID)
+	}
+
+	trace := &PipelineTrace{}
+	if err := trace.Accumulate(r); err != nil {
+		return nil, err
+	}
+	return trace, nil
+}
@@ -0,0 +1,53 @@
+package a2aclient
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestExecutionTimeoutFromDeadline(t *testing.T) {
+	tests := []struct {
+		name     string
+		deadline time.Duration // zero for none
+		explicit *int
+		want     *int
+	}{
+		{"no deadline", 0, nil, nil},
+		{"whole seconds", 10*time.Second + 500*time.Millisecond, nil, intPtr(10)},
+		{"under a second", 300 * time.Millisecond, nil, intPtr(1)},
+		{"explicit timeout kept", 10 * time.Second, intPtr(42), intPtr(42)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *int
+			client := memoryClient(func(_ context.Context, message *A2AMessage) (*A2AResponse, error) {
+				if message.Execution != nil {
+					got = message.Execution.Timeout
+				}
+				return echoResult(message), nil
+			}, nil)
+
+			ctx := context.Background()
+			if tt.deadline > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.deadline)
+				defer cancel()
+			}
+			message := directMessage(MCPToolClaudeFlowTaskOrchestrate, nil)
+			if tt.explicit != nil {
+				message.Execution = &ExecutionContext{Timeout: tt.explicit}
+			}
+			if _, err := client.SendMessage(ctx, message); err != nil {
+				t.Fatalf("SendMessage: %v", err)
+			}
+
+			switch {
+			case tt.want == nil && got != nil:
+				t.Errorf("server saw timeout %d, want none", *got)
+			case tt.want != nil && (got == nil || *got != *tt.want):
+				t.Errorf("server saw timeout %v, want %d", got, *tt.want)
+			}
+		})
+	}
+}
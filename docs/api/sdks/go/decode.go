@@ -0,0 +1,28 @@
+package a2aclient
+
+import (
+	"fmt"
+)
+
+// DecodeResult decodes a response's result into a value of type T. An
+// unsuccessful response is returned as its A2A error; a result that does not
+// fit T fails with DECODE_ERROR.
+func DecodeResult[T any](response *A2AResponse) (T, error) {
+	var result T
+	err := DecodeResultInto(response, &result)
+	return result, err
+}
+
+// DecodeResultInto decodes a response's result into dest, like DecodeResult
+func DecodeResultInto[T any](response *A2AResponse, dest *T) error {
+	if response == nil {
+		return NewA2AClientError(CodeDecode, "No response to decode", nil)
+	}
+	if err := responseError(response); err != nil {
+		return err
+	}
+	if err := decodeMap(response.Result, dest); err != nil {
+		return NewA2AClientError(CodeDecode, fmt.Sprintf("Result does not match %T: %v", *dest, err), response.MessageID)
+	}
+	return nil
+}
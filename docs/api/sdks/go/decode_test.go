@@ -0,0 +1,38 @@
+package a2aclient
+
+import (
+	"testing"
+)
+
+func TestDecodeResult(t *testing.T) {
+	type agent struct {
+		ID    string `json:"id"`
+		Count int    `json:"count"`
+	}
+
+	tests := []struct {
+		name     string
+		response *A2AResponse
+		want     agent
+		code     string
+	}{
+		{"result", &A2AResponse{Success: true, Result: map[string]interface{}{"id": "a", "count": 2.0}}, agent{ID: "a", Count: 2}, ""},
+		{"type mismatch", &A2AResponse{Success: true, Result: map[string]interface{}{"id": "a", "count": "two"}}, agent{}, CodeDecode},
+		{"failed response", &A2AResponse{Success: false, Error: &A2AError{Code: "AGENT_NOT_FOUND", Message: "no agent"}}, agent{}, "AGENT_NOT_FOUND"},
+		{"no response", nil, agent{}, CodeDecode},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeResult[agent](tt.response)
+			if tt.code != "" {
+				if !HasCode(err, tt.code) {
+					t.Fatalf("got %v, want %s", err, tt.code)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("got %+v, %v, want %+v", got, err, tt.want)
+			}
+		})
+	}
+}
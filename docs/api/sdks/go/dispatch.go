@@ -0,0 +1,97 @@
+package a2aclient
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+// dispatchQueue counts the sends in flight and caps them at limit, if
+// positive. Sends over the cap wait and are admitted by priority, then in
+// arrival order; sends already in flight are never preempted.
+type dispatchQueue struct {
+	mu      sync.Mutex
+	limit   int
+	active  int
+	seq     uint64
+	waiters []*dispatchWaiter // highest priority first
+}
+
+// dispatchWaiter is a send waiting for a slot; ready is closed when it is
+// granted one
+type dispatchWaiter struct {
+	rank  int
+	seq   uint64
+	ready chan struct{}
+}
+
+func newDispatchQueue(limit int) *dispatchQueue {
+	return &dispatchQueue{limit: limit}
+}
+
+// acquire blocks until the message may be sent or ctx is done. Each
+// successful acquire must be paired with a release.
+func (q *dispatchQueue) acquire(ctx context.Context, priority *MessagePriority) error {
+	q.mu.Lock()
+	if q.limit <= 0 || (q.active < q.limit && len(q.waiters) == 0) {
+		q.active++
+		q.mu.Unlock()
+		return nil
+	}
+
+	q.seq++
+	waiter := &dispatchWaiter{rank: priorityRank(priority), seq: q.seq, ready: make(chan struct{})}
+	index := sort.Search(len(q.waiters), func(i int) bool {
+		return q.waiters[i].rank < waiter.rank
+	})
+	q.waiters = append(q.waiters, nil)
+	copy(q.waiters[index+1:], q.waiters[index:])
+	q.waiters[index] = waiter
+	q.mu.Unlock()
+
+	select {
+	case <-waiter.ready:
+		return nil
+	case <-ctx.Done():
+		q.mu.Lock()
+		for i, w := range q.waiters {
+			if w == waiter {
+				q.waiters = append(q.waiters[:i], q.waiters[i+1:]...)
+				q.mu.Unlock()
+				return ctx.Err()
+			}
+		}
+		q.mu.Unlock()
+		// Granted a slot while giving up; pass it on
+		q.release()
+		return ctx.Err()
+	}
+}
+
+// count returns the number of sends in flight
+func (q *dispatchQueue) count() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.active
+}
+
+// release frees a slot, handing it straight to the first waiter if any
+func (q *dispatchQueue) release() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.waiters) > 0 {
+		waiter := q.waiters[0]
+		q.waiters = q.waiters[1:]
+		close(waiter.ready)
+		return
+	}
+	q.active--
+}
+
+// InFlight returns the number of requests currently awaiting a response,
+// which MaxInFlight caps. Requests waiting for a slot or backing off before
+// a retry are not counted.
+func (c *A2AClient) InFlight() int {
+	return c.dispatch.count()
+}
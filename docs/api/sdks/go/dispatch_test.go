@@ -0,0 +1,134 @@
+package a2aclient
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestMaxInFlightDispatchesByPriority(t *testing.T) {
+	release := make(chan struct{})
+	var mu sync.Mutex
+	var order []string
+	client := memoryClient(func(_ context.Context, message *A2AMessage) (*A2AResponse, error) {
+		name := message.Parameters["name"].(string)
+		if name == "blocker" {
+			<-release
+		}
+		mu.Lock()
+		order = append(order, name)
+		mu.Unlock()
+		return echoResult(message), nil
+	}, func(config *A2AClientConfig) {
+		config.MaxInFlight = 1
+	})
+
+	var wg sync.WaitGroup
+	send := func(name string, priority MessagePriority) {
+		message := directMessage(MCPToolClaudeFlowSwarmStatus, map[string]interface{}{"name": name})
+		message.Priority = &priority
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.SendMessage(context.Background(), message); err != nil {
+				t.Errorf("%s: %v", name, err)
+			}
+		}()
+	}
+	// waitFor waits until n sends are queued behind the one in flight
+	waitFor := func(n int) {
+		for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(time.Millisecond) {
+			client.dispatch.mu.Lock()
+			waiting := len(client.dispatch.waiters)
+			client.dispatch.mu.Unlock()
+			if waiting == n {
+				return
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("%d sends waiting, want %d", waiting, n)
+			}
+		}
+	}
+
+	send("blocker", MessagePriorityLow)
+	for deadline := time.Now().Add(5 * time.Second); client.InFlight() != 1; time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("first send not in flight")
+		}
+	}
+	send("low", MessagePriorityLow)
+	waitFor(1)
+	send("medium", MessagePriorityMedium)
+	waitFor(2)
+	send("critical", MessagePriorityCritical)
+	waitFor(3)
+	close(release)
+	wg.Wait()
+
+	want := []string{"blocker", "critical", "medium", "low"}
+	for i := range want {
+		if i >= len(order) || order[i] != want[i] {
+			t.Fatalf("dispatched %v, want %v", order, want)
+		}
+	}
+}
+
+func TestMaxInFlightBlocksExtraSend(t *testing.T) {
+	const limit = 2
+	started := make(chan struct{}, limit+1)
+	release := make(chan struct{})
+	client := memoryClient(func(_ context.Context, message *A2AMessage) (*A2AResponse, error) {
+		started <- struct{}{}
+		<-release
+		return echoResult(message), nil
+	}, func(config *A2AClientConfig) {
+		config.MaxInFlight = limit
+	})
+
+	done := make(chan error, limit+1)
+	send := func() {
+		go func() {
+			_, err := client.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowSwarmStatus, nil))
+			done <- err
+		}()
+	}
+	for i := 0; i < limit; i++ {
+		send()
+		<-started
+	}
+	if got := client.InFlight(); got != limit {
+		t.Fatalf("InFlight() = %d, want %d", got, limit)
+	}
+
+	send()
+	select {
+	case <-started:
+		t.Fatal("send over the cap dispatched while the others were in flight")
+	case <-time.After(50 * time.Millisecond):
+	}
+	if got := client.InFlight(); got != limit {
+		t.Errorf("InFlight() = %d with a send waiting, want %d", got, limit)
+	}
+
+	// Completing one send admits the waiting one
+	release <- struct{}{}
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("waiting send not dispatched after a slot was freed")
+	}
+
+	close(release)
+	for i := 0; i < limit; i++ {
+		if err := <-done; err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := client.InFlight(); got != 0 {
+		t.Errorf("InFlight() = %d after every send completed, want 0", got)
+	}
+}
@@ -0,0 +1,177 @@
+package a2aclient
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Endpoint selection strategies for A2AClientConfig.EndpointStrategy
+const (
+	EndpointPrimaryWithFailover = "primary-with-failover"
+	EndpointRoundRobin          = "round-robin"
+	EndpointRandom              = "random"
+)
+
+// EndpointHealthConfig controls when an endpoint is skipped as unhealthy
+type EndpointHealthConfig struct {
+	// FailureThreshold is the number of transport failures within Window
+	// after which the endpoint is skipped (default 1)
+	FailureThreshold int `json:"failure_threshold"`
+	// Window is how long a failure counts against the endpoint (default 30s)
+	Window time.Duration `json:"window"`
+}
+
+// endpointSet chooses between the configured base URLs and tracks their
+// recent transport failures
+type endpointSet struct {
+	mu       sync.Mutex
+	urls     []string
+	strategy string
+	health   EndpointHealthConfig
+	failures map[string][]time.Time
+	next     int
+}
+
+// newEndpointSet creates an endpoint set over the given base URLs
+func newEndpointSet(urls []string, strategy string, health EndpointHealthConfig) *endpointSet {
+	return &endpointSet{
+		urls:     urls,
+		strategy: strategy,
+		health:   health,
+		failures: make(map[string][]time.Time),
+	}
+}
+
+// pick chooses the endpoint for the next attempt, preferring healthy
+// endpoints not yet tried by the current request. When every endpoint is
+// unhealthy or tried, all of them are candidates again.
+func (s *endpointSet) pick(tried *endpointAttempts) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	var candidates []int
+	for i, url := range s.urls {
+		if s.healthy(url, now) && !tried.has(url) {
+			candidates = append(candidates, i)
+		}
+	}
+	if len(candidates) == 0 {
+		for i, url := range s.urls {
+			if !tried.has(url) {
+				candidates = append(candidates, i)
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		for i := range s.urls {
+			candidates = append(candidates, i)
+		}
+	}
+
+	switch s.strategy {
+	case EndpointRoundRobin:
+		// The first candidate at or after the rotating position
+		start := s.next % len(s.urls)
+		s.next++
+		best := candidates[0]
+		for _, i := range candidates {
+			if i >= start {
+				best = i
+				break
+			}
+		}
+		return s.urls[best]
+	case EndpointRandom:
+		return s.urls[candidates[rand.Intn(len(candidates))]]
+	default:
+		return s.urls[candidates[0]]
+	}
+}
+
+// healthy reports whether the endpoint has fewer recent failures than the
+// threshold, forgetting failures older than the window. The caller must hold
+// mu.
+func (s *endpointSet) healthy(url string, now time.Time) bool {
+	failures := s.failures[url]
+	recent := failures[:0]
+	for _, at := range failures {
+		if now.Sub(at) < s.health.Window {
+			recent = append(recent, at)
+		}
+	}
+	s.failures[url] = recent
+	return len(recent) < s.health.FailureThreshold
+}
+
+// recordFailure counts a transport failure against the endpoint
+func (s *endpointSet) recordFailure(url string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures[url] = append(s.failures[url], time.Now())
+}
+
+// reset forgets every endpoint's failures and restarts the rotation
+func (s *endpointSet) reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = make(map[string][]time.Time)
+	s.next = 0
+}
+
+// recordSuccess clears the endpoint's failures
+func (s *endpointSet) recordSuccess(url string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.failures, url)
+}
+
+// endpointAttempts records the endpoints one logical request has failed
+// against so its retries move on to the next one
+type endpointAttempts struct {
+	mu    sync.Mutex
+	tried map[string]bool
+}
+
+type endpointAttemptsKey struct{}
+
+// withEndpointAttempts returns a context that tracks the endpoints tried by
+// the request it carries
+func withEndpointAttempts(ctx context.Context) context.Context {
+	return context.WithValue(ctx, endpointAttemptsKey{}, &endpointAttempts{tried: make(map[string]bool)})
+}
+
+// endpointAttemptsFrom returns the tracker carried by ctx, or nil
+func endpointAttemptsFrom(ctx context.Context) *endpointAttempts {
+	attempts, _ := ctx.Value(endpointAttemptsKey{}).(*endpointAttempts)
+	return attempts
+}
+
+// has reports whether the request already failed against the endpoint
+func (a *endpointAttempts) has(url string) bool {
+	if a == nil {
+		return false
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.tried[url]
+}
+
+// add records a failed attempt against the endpoint
+func (a *endpointAttempts) add(url string) {
+	if a == nil {
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.tried[url] = true
+}
+
+// endpointFailed records a transport failure of a request against an
+// endpoint
+func (c *A2AClient) endpointFailed(ctx context.Context, baseURL string) {
+	c.endpoints.recordFailure(baseURL)
+	endpointAttemptsFrom(ctx).add(baseURL)
+}
@@ -0,0 +1,93 @@
+package a2aclient
+
+import (
+	"fmt"
+	"os"
+)
+
+// ValidateEnvName checks that name is a valid environment variable name:
+// letters, digits and underscores, not starting with a digit
+func ValidateEnvName(name string) error {
+	if name == "" {
+		return fmt.Errorf("environment variable name is required")
+	}
+	for i, r := range name {
+		switch {
+		case r == '_', r >= 'A' && r <= 'Z', r >= 'a' && r <= 'z':
+		case r >= '0' && r <= '9' && i > 0:
+		default:
+			return fmt.Errorf("invalid environment variable name %q", name)
+		}
+	}
+	return nil
+}
+
+// SetEnv sets an environment variable for the agent process executing the
+// message
+func (e *ExecutionContext) SetEnv(key, value string) error {
+	if err := ValidateEnvName(key); err != nil {
+		return err
+	}
+	if e.Environment == nil {
+		e.Environment = make(map[string]interface{})
+	}
+	e.Environment[key] = value
+	return nil
+}
+
+// InheritEnv copies the named variables from the client process environment.
+// Variables that are unset in the client process or already set on the
+// context are left alone.
+func (e *ExecutionContext) InheritEnv(names ...string) error {
+	for _, name := range names {
+		if _, exists := e.Environment[name]; exists {
+			continue
+		}
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			continue
+		}
+		if err := e.SetEnv(name, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WithEnv sets an environment variable for the agent process executing the
+// message. Invalid variable names are reported by Build.
+func (b *MessageBuilder) WithEnv(key, value string) *MessageBuilder {
+	if err := b.execution().SetEnv(key, value); err != nil {
+		b.errs = append(b.errs, err)
+	}
+	return b
+}
+
+// InheritEnv copies the named variables from the client process environment
+// into the message's execution environment
+func (b *MessageBuilder) InheritEnv(names ...string) *MessageBuilder {
+	if err := b.execution().InheritEnv(names...); err != nil {
+		b.errs = append(b.errs, err)
+	}
+	return b
+}
+
+// applyInheritedEnv copies the configured InheritEnv variables into the
+// message's execution environment
+func (c *A2AClient) applyInheritedEnv(message *A2AMessage) {
+	if len(c.config.InheritEnv) == 0 {
+		return
+	}
+
+	execution := message.Execution
+	if execution == nil {
+		execution = &ExecutionContext{}
+	}
+	for _, name := range c.config.InheritEnv {
+		// Invalid names cannot be set on the agent process and are skipped
+		_ = execution.InheritEnv(name)
+	}
+	if message.Execution == nil && len(execution.Environment) > 0 {
+		message.Execution = execution
+	}
+}
@@ -0,0 +1,88 @@
+package a2aclient
+
+import (
+	"context"
+	"errors"
+)
+
+// Error codes set by the client on A2AClientError. Servers may report other
+// codes, which are passed through unchanged.
+const (
+	CodeValidation           = "VALIDATION_ERROR"
+	CodeDecode               = "DECODE_ERROR"
+	CodeTransform            = "TRANSFORM_ERROR"
+	CodeTimeout              = "A2A_TIMEOUT_ERROR"
+	CodeNetworkTimeout       = "NETWORK_TIMEOUT"
+	CodeConnectionFailed     = "CONNECTION_FAILED"
+	CodeConnectionLost       = "CONNECTION_LOST"
+	CodeDisconnected         = "DISCONNECTED"
+	CodeWebSocketRequired    = "WEBSOCKET_REQUIRED"
+	CodeStreamingUnsupported = "STREAMING_UNSUPPORTED"
+	CodeRateLimited          = "RATE_LIMITED"
+	CodeCircuitOpen          = "CIRCUIT_OPEN"
+	CodeRetryBudgetExceeded  = "RETRY_BUDGET_EXCEEDED"
+	CodeRequestFailed        = "A2A_REQUEST_FAILED"
+	CodeQueued               = "QUEUED"
+	CodeQueueFull            = "QUEUE_FULL"
+	CodeTTLExceeded          = "TTL_EXCEEDED"
+	CodeMessageExpired       = "MESSAGE_EXPIRED"
+	CodeFrameTooLarge        = "FRAME_TOO_LARGE"
+	CodeResponseTooLarge     = "RESPONSE_TOO_LARGE"
+	CodeInsufficientData     = "INSUFFICIENT_DATA"
+	CodeStopped              = "STOPPED"
+	CodeClientShutdown       = "CLIENT_SHUTDOWN"
+	CodeClientReset          = "CLIENT_RESET"
+	CodeTaskFailed           = "TASK_FAILED"
+	CodeSwarmFailed          = "SWARM_FAILED"
+	CodeAgentNotFound        = "AGENT_NOT_FOUND"
+	CodeAuthFailed           = "AUTH_FAILED"
+	CodePipelineCycle        = "PIPELINE_CYCLE"
+	CodeServerError          = "SERVER_ERROR"
+)
+
+// Sentinel errors for use with errors.Is, which matches any A2AClientError
+// with the same code
+var (
+	ErrTimeout          = NewA2AClientError(CodeTimeout, "Request timed out", nil)
+	ErrConnectionFailed = NewA2AClientError(CodeConnectionFailed, "Connection failed", nil)
+	ErrConnectionLost   = NewA2AClientError(CodeConnectionLost, "Connection lost", nil)
+	ErrRateLimited      = NewA2AClientError(CodeRateLimited, "Rate limited", nil)
+	ErrCircuitOpen      = NewA2AClientError(CodeCircuitOpen, "Circuit breaker is open", nil)
+	ErrValidation       = NewA2AClientError(CodeValidation, "Validation failed", nil)
+)
+
+// DefaultRetryableErrors are the codes the default retry policy retries
+var DefaultRetryableErrors = []string{CodeNetworkTimeout, CodeConnectionFailed, CodeRateLimited}
+
+// wrapError creates a client error for a failure caused by err, which is kept
+// as both Details and the wrapped error
+func wrapError(code, message string, err error) *A2AClientError {
+	clientErr := NewA2AClientError(code, message, err)
+	clientErr.Wrapped = err
+	return clientErr
+}
+
+// HasCode reports whether err is, or wraps, an A2AClientError with the
+// given code
+func HasCode(err error, code string) bool {
+	var clientErr *A2AClientError
+	return errors.As(err, &clientErr) && clientErr.Code == code
+}
+
+// IsTimeout reports whether err is a request or network timeout, including
+// the caller's context deadline running out
+func IsTimeout(err error) bool {
+	return HasCode(err, CodeTimeout) || HasCode(err, CodeNetworkTimeout) ||
+		errors.Is(err, context.DeadlineExceeded)
+}
+
+// IsRetryable reports whether err has one of the DefaultRetryableErrors
+// codes, i.e. whether sending the message again may succeed
+func IsRetryable(err error) bool {
+	for _, code := range DefaultRetryableErrors {
+		if HasCode(err, code) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+package a2aclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestErrorsIsMatchesSentinelsByCode(t *testing.T) {
+	tests := []struct {
+		err      error
+		sentinel error
+		want     bool
+	}{
+		{NewA2AClientError(CodeTimeout, "WebSocket message timeout", nil), ErrTimeout, true},
+		{NewA2AClientError(CodeNetworkTimeout, "dial timed out", nil), ErrTimeout, true},
+		{NewA2AClientError(CodeConnectionLost, "gone", nil), ErrConnectionLost, true},
+		{fmt.Errorf("send: %w", NewA2AClientError(CodeRateLimited, "slow down", nil)), ErrRateLimited, true},
+		{NewA2AClientError(CodeTimeout, "late", nil), ErrConnectionFailed, false},
+		{NewA2AClientError(CodeConnectionFailed, "refused", nil), ErrTimeout, false},
+		{errors.New("plain"), ErrValidation, false},
+	}
+	for _, tt := range tests {
+		if got := errors.Is(tt.err, tt.sentinel); got != tt.want {
+			t.Errorf("errors.Is(%v, %v) = %v, want %v", tt.err, tt.sentinel, got, tt.want)
+		}
+	}
+}
+
+func TestErrorsAsThroughWrapping(t *testing.T) {
+	cause := io.ErrUnexpectedEOF
+	err := fmt.Errorf("interceptor: %w", wrapError(CodeConnectionFailed, "failed to read", cause))
+
+	var clientErr *A2AClientError
+	if !errors.As(err, &clientErr) {
+		t.Fatal("errors.As found no A2AClientError")
+	}
+	if clientErr.Code != CodeConnectionFailed {
+		t.Errorf("got code %s, want %s", clientErr.Code, CodeConnectionFailed)
+	}
+	if !errors.Is(err, cause) {
+		t.Error("the underlying cause is not reachable through Unwrap")
+	}
+	if !HasCode(err, CodeConnectionFailed) || HasCode(err, CodeTimeout) {
+		t.Error("HasCode does not see through the wrapping")
+	}
+	if !IsRetryable(err) {
+		t.Error("IsRetryable does not see through the wrapping")
+	}
+}
+
+func TestWrappedErrorsAreRetried(t *testing.T) {
+	attempts := 0
+	client := NewA2AClient(&A2AClientConfig{
+		BaseURL:     "http://a2a.test",
+		RetryPolicy: fastRetries(2),
+		Transport: transportFunc(func(ctx context.Context, message *A2AMessage) (*A2AResponse, error) {
+			attempts++
+			if attempts < 3 {
+				return nil, fmt.Errorf("custom transport: %w", NewA2AClientError(CodeConnectionFailed, "refused", nil))
+			}
+			return &A2AResponse{Success: true}, nil
+		}),
+	})
+
+	if _, err := client.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowAgentList, nil)); err != nil {
+		t.Fatalf("SendMessage: %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("got %d attempts, want 3", attempts)
+	}
+}
+
+func TestRetryAfterDelayThroughWrapping(t *testing.T) {
+	want := 7 * time.Millisecond
+	err := fmt.Errorf("custom transport: %w", NewA2AClientError(CodeRateLimited, "slow down", want))
+	if delay, ok := retryAfterDelay(err); !ok || delay != want {
+		t.Errorf("got %v, %v; want %v", delay, ok, want)
+	}
+	if _, ok := retryAfterDelay(fmt.Errorf("x: %w", NewA2AClientError(CodeRateLimited, "slow down", nil))); ok {
+		t.Error("a delay was found without one in the details")
+	}
+}
+
+// transportFunc adapts a function to Transport
+type transportFunc func(ctx context.Context, message *A2AMessage) (*A2AResponse, error)
+
+func (f transportFunc) Send(ctx context.Context, message *A2AMessage) (*A2AResponse, error) {
+	return f(ctx, message)
+}
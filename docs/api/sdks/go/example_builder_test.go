@@ -0,0 +1,67 @@
+package a2aclient_test
+
+import (
+	"fmt"
+	"time"
+
+	a2aclient "github.com/gemini-flow/a2a-client-go"
+)
+
+func ExampleNewMessage() {
+	msg, err := a2aclient.NewMessage(a2aclient.MCPToolClaudeFlowSwarmInit).
+		To(a2aclient.Utils.BroadcastTarget(nil)).
+		WithCoordination(a2aclient.Utils.ConsensusCoordination("majority", nil, nil)).
+		WithParams(map[string]interface{}{"topology": "mesh", "maxAgents": 8}).
+		WithPriority(a2aclient.MessagePriorityHigh).
+		Build()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(msg.ToolName)
+	fmt.Println(msg.Target.BroadcastTarget.Type, msg.Coordination.ConsensusCoordination.ConsensusType)
+	fmt.Println(msg.Parameters["topology"], msg.Parameters["maxAgents"], *msg.Priority)
+	// Output:
+	// mcp__gemini-flow__swarm_init
+	// broadcast majority
+	// mesh 8 high
+}
+
+func ExampleNewMessage_pipeline() {
+	stages := []a2aclient.PipelineStage{
+		{Name: "fetch", ToolName: string(a2aclient.MCPToolClaudeFlowGitHubRepoAnalyze)},
+		{Name: "report", ToolName: string(a2aclient.MCPToolClaudeFlowPerformanceReport), InputTransform: "$.hotspots"},
+	}
+
+	msg, err := a2aclient.NewMessage(a2aclient.MCPToolClaudeFlowTaskOrchestrate).
+		To(a2aclient.Utils.GroupTarget(a2aclient.AgentRoleCoordinator, nil, nil, "")).
+		WithCoordination(a2aclient.Utils.PipelineCoordination(stages, "abort", true)).
+		WithParam("task", "analyze repository").
+		WithTTL(300).
+		WithExecutionTimeout(90 * time.Second).
+		Build()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	pipeline := msg.Coordination.PipelineCoordination
+	fmt.Println(len(pipeline.Stages), "stages,", pipeline.FailureStrategy, "on failure")
+	fmt.Println("TTL", *msg.TTL, "timeout", *msg.Execution.Timeout)
+	// Output:
+	// 2 stages, abort on failure
+	// TTL 300 timeout 90
+}
+
+func ExampleMessageBuilder_Build() {
+	// No target or coordination, and a TTL that is not positive
+	_, err := a2aclient.NewMessage(a2aclient.MCPToolClaudeFlowSwarmStatus).
+		WithTTL(0).
+		Build()
+	fmt.Println(err)
+	// Output:
+	// A2A Error [VALIDATION_ERROR]: TTL must be positive
+	// A2A Error [VALIDATION_ERROR]: Message target is required
+	// A2A Error [VALIDATION_ERROR]: Coordination mode is required
+}
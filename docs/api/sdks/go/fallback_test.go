@@ -0,0 +1,51 @@
+package a2aclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestFallbackToHTTPWhileWebSocketDown(t *testing.T) {
+	var wsSends, httpSends int32
+	server := newWSServer(t, func(_ *websocket.Conn, message *A2AMessage) *A2AResponse {
+		atomic.AddInt32(&wsSends, 1)
+		return echoResult(message)
+	})
+	server.http = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&httpSends, 1)
+		io.WriteString(w, okResponse)
+	})
+	client := connectWS(t, server, func(config *A2AClientConfig) {
+		config.FallbackToHTTP = true
+		config.ReconnectEnabled = true
+		config.ReconnectPolicy = &ReconnectPolicy{MaxAttempts: 1, BackoffStrategy: "linear", BaseDelay: time.Hour, MaxDelay: time.Hour}
+	})
+	send := func() {
+		t.Helper()
+		if _, err := client.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowSwarmStatus, nil)); err != nil {
+			t.Fatalf("SendMessage: %v", err)
+		}
+	}
+
+	send()
+	if wsSends != 1 || httpSends != 0 {
+		t.Fatalf("connected send went %d times over WebSocket and %d over HTTP, want WebSocket", wsSends, httpSends)
+	}
+
+	server.dropConnections()
+	for deadline := time.Now().Add(5 * time.Second); !client.fallingBack(); time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("client did not notice the dropped socket")
+		}
+	}
+	send()
+	if atomic.LoadInt32(&wsSends) != 1 || atomic.LoadInt32(&httpSends) != 1 {
+		t.Errorf("send while down went %d times over WebSocket and %d over HTTP, want HTTP", wsSends-1, httpSends)
+	}
+}
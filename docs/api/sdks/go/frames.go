@@ -0,0 +1,123 @@
+package a2aclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// errFrameTooLarge is returned by readFrame for frames over the size limit
+var errFrameTooLarge = errors.New("websocket frame exceeds size limit")
+
+// readFrame reads a whole frame of at most limit bytes. Oversized frames are
+// read to the end and discarded so the connection stays usable; the first
+// limit bytes are returned along with errFrameTooLarge.
+func readFrame(reader io.Reader, limit int64) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(reader, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) <= limit {
+		return data, nil
+	}
+
+	if _, err := io.Copy(io.Discard, reader); err != nil {
+		return nil, err
+	}
+	return data[:limit], errFrameTooLarge
+}
+
+// rejectOversizedFrame fails the request an oversized frame was meant for,
+// if its correlation or message ID appears in the part that was read
+func (c *A2AClient) rejectOversizedFrame(prefix []byte) {
+	correlationID, messageID := c.frameIDs(prefix)
+	if correlationID == "" && messageID == "" {
+		return
+	}
+
+	c.routeResponse(&A2AResponse{
+		MessageID:     messageID,
+		CorrelationID: correlationID,
+		Success:       false,
+		Error: &A2AError{
+			Code:        CodeFrameTooLarge,
+			Message:     fmt.Sprintf("Response frame exceeds the %d byte limit", c.config.MaxFrameBytes),
+			Details:     c.config.MaxFrameBytes,
+			Recoverable: false,
+		},
+		Final: true,
+	})
+}
+
+// frameIDs extracts the top-level correlation_id and message_id from a
+// possibly truncated response encoded with the configured codec
+func (c *A2AClient) frameIDs(prefix []byte) (correlationID, messageID string) {
+	if c.usesJSON() {
+		return jsonFrameIDs(prefix)
+	}
+
+	var ids struct {
+		CorrelationID string `json:"correlation_id"`
+		MessageID     string `json:"message_id"`
+	}
+	if codec, ok := c.config.Codec.(PrefixUnmarshaler); ok {
+		codec.UnmarshalPrefix(prefix, &ids)
+	} else {
+		c.config.Codec.Unmarshal(prefix, &ids)
+	}
+	return ids.CorrelationID, ids.MessageID
+}
+
+// jsonFrameIDs scans a possibly truncated JSON response for its top-level
+// correlation_id and message_id
+func jsonFrameIDs(prefix []byte) (correlationID, messageID string) {
+	decoder := json.NewDecoder(bytes.NewReader(prefix))
+	depth := 0
+	isKey := false
+	key := ""
+
+	for correlationID == "" || messageID == "" {
+		token, err := decoder.Token()
+		if err != nil {
+			return correlationID, messageID
+		}
+
+		if delim, ok := token.(json.Delim); ok {
+			switch delim {
+			case '{', '[':
+				depth++
+				if depth == 1 {
+					isKey = true
+				}
+			case '}', ']':
+				depth--
+				if depth == 1 {
+					isKey = true
+				}
+			}
+			continue
+		}
+		if depth != 1 {
+			continue
+		}
+
+		if isKey {
+			key, _ = token.(string)
+			isKey = false
+			continue
+		}
+		if value, ok := token.(string); ok {
+			switch key {
+			case "correlation_id":
+				correlationID = value
+			case "message_id":
+				messageID = value
+			}
+		}
+		isKey = true
+	}
+
+	return correlationID, messageID
+}
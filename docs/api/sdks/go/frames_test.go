@@ -0,0 +1,88 @@
+package a2aclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/gemini-flow/a2a-client-go/msgpack"
+	"github.com/gorilla/websocket"
+)
+
+func TestFrameIDsDecodeTruncatedFrames(t *testing.T) {
+	response := &A2AResponse{
+		MessageID:     "msg-1",
+		CorrelationID: "corr-1",
+		Success:       true,
+		Result:        strings.Repeat("r", 1000),
+	}
+
+	for _, codec := range []Codec{JSONCodec{}, msgpack.Codec{}} {
+		data, err := codec.Marshal(response)
+		if err != nil {
+			t.Fatal(err)
+		}
+		client := NewA2AClient(&A2AClientConfig{BaseURL: "http://a2a.test", Codec: codec})
+
+		correlationID, messageID := client.frameIDs(data[:200])
+		if correlationID != "corr-1" || messageID != "msg-1" {
+			t.Errorf("%T: frameIDs = %q, %q, want corr-1, msg-1", codec, correlationID, messageID)
+		}
+	}
+}
+
+func TestOversizedHTTPResponseRejected(t *testing.T) {
+	// An endless result: reading the whole body would never finish
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `{"message_id":"m","success":true,"result":"`)
+		chunk := strings.Repeat("x", 32<<10)
+		for r.Context().Err() == nil {
+			if _, err := io.WriteString(w, chunk); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	const limit = 1 << 20
+	client := NewA2AClient(&A2AClientConfig{BaseURL: server.URL, RetryPolicy: fastRetries(0), MaxResponseBytes: limit})
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	_, err := client.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowSwarmStatus, nil))
+	runtime.ReadMemStats(&after)
+
+	if !HasCode(err, CodeResponseTooLarge) {
+		t.Fatalf("got %v, want RESPONSE_TOO_LARGE", err)
+	}
+	if allocated := after.TotalAlloc - before.TotalAlloc; allocated > 16*limit {
+		t.Errorf("allocated %d bytes for a %d byte limit", allocated, limit)
+	}
+}
+
+func TestOversizedWebSocketFrameRejected(t *testing.T) {
+	server := newWSServer(t, func(_ *websocket.Conn, message *A2AMessage) *A2AResponse {
+		response := echoResult(message)
+		if message.Parameters["large"] == true {
+			response.Result = strings.Repeat("x", 64<<10)
+		}
+		return response
+	})
+	client := connectWS(t, server, func(config *A2AClientConfig) {
+		config.RetryPolicy = fastRetries(0)
+		config.MaxFrameBytes = 4 << 10
+	})
+
+	_, err := client.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowSwarmStatus, map[string]interface{}{"large": true}))
+	if !HasCode(err, CodeFrameTooLarge) {
+		t.Fatalf("got %v, want FRAME_TOO_LARGE", err)
+	}
+	// The connection survives the oversized frame
+	if _, err := client.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowSwarmStatus, nil)); err != nil {
+		t.Errorf("send after the oversized frame: %v", err)
+	}
+}
@@ -0,0 +1,108 @@
+package a2aclient
+
+import (
+	"context"
+	"fmt"
+)
+
+// repoAnalyzeTimeout is the execution timeout for repository analysis, which
+// can take minutes on large repositories
+const repoAnalyzeTimeout = 300
+
+// RepoAnalyzeOptions configures a repository analysis
+type RepoAnalyzeOptions struct {
+	Depth               string // "shallow", "standard" (default), "deep"
+	IncludePRMetrics    bool
+	IncludeIssueMetrics bool
+}
+
+// RepoAnalysis is the decoded result of a repository analysis
+type RepoAnalysis struct {
+	Repository      string               `json:"repository"`
+	Languages       map[string]float64   `json:"languages"` // percentage of code by language
+	Complexity      RepoComplexity       `json:"complexity"`
+	Hotspots        []RepoHotspot        `json:"hotspots"`
+	Recommendations []string             `json:"recommendations"`
+	PullRequests    *RepoActivityMetrics `json:"pull_requests,omitempty"` // set if IncludePRMetrics
+	Issues          *RepoActivityMetrics `json:"issues,omitempty"`        // set if IncludeIssueMetrics
+}
+
+// RepoComplexity holds the complexity metrics of a repository
+type RepoComplexity struct {
+	Files                int     `json:"files"`
+	LinesOfCode          int     `json:"lines_of_code"`
+	AverageCyclomatic    float64 `json:"average_cyclomatic"`
+	MaxCyclomatic        int     `json:"max_cyclomatic"`
+	MaintainabilityIndex float64 `json:"maintainability_index"`
+}
+
+// RepoHotspot is a file that is both complex and frequently changed
+type RepoHotspot struct {
+	Path       string  `json:"path"`
+	Complexity float64 `json:"complexity"`
+	Churn      int     `json:"churn"` // commits touching the file
+	Reason     string  `json:"reason,omitempty"`
+}
+
+// RepoActivityMetrics summarizes pull request or issue activity
+type RepoActivityMetrics struct {
+	Open              int     `json:"open"`
+	Closed            int     `json:"closed"`
+	AverageCloseHours float64 `json:"average_close_hours"`
+}
+
+// AnalyzeRepo analyzes the GitHub repository owner/repo on an analyst agent
+// and decodes the report
+func (c *A2AClient) AnalyzeRepo(ctx context.Context, owner, repo string, opts RepoAnalyzeOptions) (*RepoAnalysis, error) {
+	if owner == "" || repo == "" {
+		return nil, NewA2AClientError(CodeValidation, "Repository owner and name are required", nil)
+	}
+	depth := opts.Depth
+	if depth == "" {
+		depth = "standard"
+	}
+
+	message := &A2AMessage{
+		Target: AgentTarget{
+			GroupTarget: &GroupTarget{
+				Type:              "group",
+				Role:              AgentRoleAnalyst,
+				MaxAgents:         intPtr(1),
+				SelectionStrategy: "load-balanced",
+			},
+		},
+		ToolName: MCPToolClaudeFlowGitHubRepoAnalyze,
+		Parameters: map[string]interface{}{
+			"repo":                owner + "/" + repo,
+			"depth":               depth,
+			"includePRMetrics":    opts.IncludePRMetrics,
+			"includeIssueMetrics": opts.IncludeIssueMetrics,
+		},
+		Execution: &ExecutionContext{
+			Timeout: intPtr(repoAnalyzeTimeout),
+		},
+		Coordination: CoordinationMode{
+			DirectCoordination: &DirectCoordination{
+				Mode:    "direct",
+				Timeout: intPtr(repoAnalyzeTimeout),
+			},
+		},
+	}
+
+	response, err := c.SendMessage(ctx, message)
+	if err != nil {
+		return nil, err
+	}
+	if err := responseError(response); err != nil {
+		return nil, err
+	}
+
+	var analysis RepoAnalysis
+	if err := decodeMap(response.Result, &analysis); err != nil {
+		return nil, NewA2AClientError(CodeDecode, fmt.Sprintf("Failed to decode repository analysis: %v", err), response.Result)
+	}
+	if analysis.Repository == "" {
+		analysis.Repository = owner + "/" + repo
+	}
+	return &analysis, nil
+}
@@ -0,0 +1,47 @@
+package a2aclient
+
+import (
+	"context"
+	"testing"
+)
+
+func TestAnalyzeRepoDecodesReport(t *testing.T) {
+	var sent *A2AMessage
+	client := memoryClient(func(_ context.Context, message *A2AMessage) (*A2AResponse, error) {
+		sent = message
+		return &A2AResponse{Success: true, Result: map[string]interface{}{
+			"languages":     map[string]interface{}{"Go": 80.5, "Shell": 19.5},
+			"complexity":    map[string]interface{}{"files": 42, "lines_of_code": 9000, "max_cyclomatic": 31},
+			"hotspots":      []interface{}{map[string]interface{}{"path": "a2a_client.go", "complexity": 12.5, "churn": 40}},
+			"pull_requests": map[string]interface{}{"open": 3, "closed": 120},
+		}}, nil
+	}, nil)
+
+	if _, err := client.AnalyzeRepo(context.Background(), "", "repo", RepoAnalyzeOptions{}); !HasCode(err, CodeValidation) {
+		t.Errorf("no owner: got %v, want VALIDATION_ERROR", err)
+	}
+
+	analysis, err := client.AnalyzeRepo(context.Background(), "gemini-flow", "sdk", RepoAnalyzeOptions{IncludePRMetrics: true})
+	if err != nil {
+		t.Fatalf("AnalyzeRepo: %v", err)
+	}
+	if sent.Parameters["repo"] != "gemini-flow/sdk" || sent.Parameters["depth"] != "standard" || sent.Parameters["includePRMetrics"] != true {
+		t.Errorf("params %v", sent.Parameters)
+	}
+	if sent.Execution == nil || *sent.Execution.Timeout != repoAnalyzeTimeout {
+		t.Errorf("execution %+v, want the %ds analysis timeout", sent.Execution, repoAnalyzeTimeout)
+	}
+
+	if analysis.Repository != "gemini-flow/sdk" {
+		t.Errorf("repository %q, want it filled in from the request", analysis.Repository)
+	}
+	if analysis.Languages["Go"] != 80.5 || analysis.Complexity.Files != 42 || analysis.Complexity.MaxCyclomatic != 31 {
+		t.Errorf("decoded %+v", analysis)
+	}
+	if len(analysis.Hotspots) != 1 || analysis.Hotspots[0].Churn != 40 {
+		t.Errorf("hotspots %+v", analysis.Hotspots)
+	}
+	if analysis.PullRequests == nil || analysis.PullRequests.Closed != 120 || analysis.Issues != nil {
+		t.Errorf("pull requests %+v, issues %+v, want only pull request metrics", analysis.PullRequests, analysis.Issues)
+	}
+}
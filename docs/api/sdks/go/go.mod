@@ -4,9 +4,9 @@ go 1.21
 
 require (
 	github.com/google/uuid v1.4.0
-	github.com/gorilla/websocket v1.5.1
+	github.com/gorilla/websocket v1.5.3
+	go.opentelemetry.io/otel v1.21.0
+	go.opentelemetry.io/otel/trace v1.21.0
+	golang.org/x/crypto v0.26.0
+	golang.org/x/oauth2 v0.22.0
 )
-
-require (
-	golang.org/x/net v0.17.0 // indirect
-)
\ No newline at end of file
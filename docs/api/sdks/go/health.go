@@ -0,0 +1,62 @@
+package a2aclient
+
+import (
+	"context"
+	"time"
+)
+
+// healthCheckTimeout bounds a health check, on the client and the server
+const healthCheckTimeout = 5 * time.Second
+
+// HealthCheck asks a coordinator whether the system is healthy and reports
+// the answer with the full response. The check is sent once with a short
+// timeout: retrying would only hide the failure a probe exists to report.
+func (c *A2AClient) HealthCheck(ctx context.Context) (bool, *A2AResponse, error) {
+	message := &A2AMessage{
+		Target: AgentTarget{
+			GroupTarget: &GroupTarget{
+				Type:              "group",
+				Role:              AgentRoleCoordinator,
+				MaxAgents:         intPtr(1),
+				SelectionStrategy: "load-balanced",
+			},
+		},
+		ToolName: MCPToolClaudeFlowHealthCheck,
+		Coordination: CoordinationMode{
+			DirectCoordination: &DirectCoordination{
+				Mode:    "direct",
+				Retries: intPtr(0),
+			},
+		},
+		Execution: &ExecutionContext{
+			Timeout: intPtr(int(healthCheckTimeout / time.Second)),
+		},
+		Priority: messagePriorityPtr(MessagePriorityHigh),
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	ctx, end, err := c.beginRequest(ctx)
+	if err != nil {
+		return false, nil, err
+	}
+	defer end()
+
+	c.prepareMessage(message)
+	response, err := c.doSendMessage(ctx, message)
+	if err != nil {
+		return false, nil, c.shutdownError(err)
+	}
+	return response.Success, response, nil
+}
+
+// Ping runs a health check and returns nil only if it reports healthy, for
+// use in readiness and liveness endpoints
+func (c *A2AClient) Ping(ctx context.Context) error {
+	_, response, err := c.HealthCheck(ctx)
+	if err != nil {
+		return err
+	}
+	return responseError(response)
+}
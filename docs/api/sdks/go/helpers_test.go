@@ -0,0 +1,151 @@
+package a2aclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// wsServer is a fake A2A server whose /ws endpoint decodes every frame and
+// passes it to handle, writing back the response handle returns, if any.
+// Other paths are served by http if set.
+type wsServer struct {
+	*httptest.Server
+	handle func(conn *websocket.Conn, message *A2AMessage) *A2AResponse
+	http   http.Handler
+
+	mu    sync.Mutex
+	conns []*websocket.Conn
+}
+
+func newWSServer(t testing.TB, handle func(conn *websocket.Conn, message *A2AMessage) *A2AResponse) *wsServer {
+	t.Helper()
+	s := &wsServer{handle: handle}
+	var upgrader websocket.Upgrader
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ws" {
+			if s.http != nil {
+				s.http.ServeHTTP(w, r)
+				return
+			}
+			http.NotFound(w, r)
+			return
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		s.conns = append(s.conns, conn)
+		s.mu.Unlock()
+		defer conn.Close()
+
+		var writeMu sync.Mutex
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			var message A2AMessage
+			if err := json.Unmarshal(data, &message); err != nil {
+				t.Errorf("server received a corrupt frame: %v: %q", err, data)
+				continue
+			}
+			go func() {
+				if response := s.handle(conn, &message); response != nil {
+					writeMu.Lock()
+					defer writeMu.Unlock()
+					conn.WriteJSON(response)
+				}
+			}()
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// dropConnections closes the server side of every WebSocket accepted so far
+func (s *wsServer) dropConnections() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, conn := range s.conns {
+		conn.Close()
+	}
+	s.conns = nil
+}
+
+// connections returns how many WebSocket connections the server accepted
+func (s *wsServer) connections() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.conns)
+}
+
+// echoResult answers a message successfully, echoing its IDs and its
+// parameters as the result
+func echoResult(message *A2AMessage) *A2AResponse {
+	return &A2AResponse{
+		MessageID:     message.ID,
+		CorrelationID: message.CorrelationID,
+		Success:       true,
+		Result:        message.Parameters,
+	}
+}
+
+// connectWS creates a client for the server with WebSocket enabled and
+// connects it, applying configure to the config first if not nil
+func connectWS(t testing.TB, s *wsServer, configure func(*A2AClientConfig)) *A2AClient {
+	t.Helper()
+	config := &A2AClientConfig{BaseURL: s.URL, WebSocketEnabled: true}
+	if configure != nil {
+		configure(config)
+	}
+	client := NewA2AClient(config)
+	if err := client.Connect(context.Background()); err != nil {
+		t.Fatalf("Connect: %v", err)
+	}
+	t.Cleanup(func() { client.Disconnect() })
+	return client
+}
+
+// directMessage returns a direct message to agent "a" calling toolName
+func directMessage(toolName MCPToolName, params map[string]interface{}) *A2AMessage {
+	return &A2AMessage{
+		Target:     AgentTarget{SingleTarget: &SingleTarget{Type: "single", AgentID: "a"}},
+		ToolName:   toolName,
+		Parameters: params,
+		Coordination: CoordinationMode{
+			DirectCoordination: &DirectCoordination{Mode: "direct"},
+		},
+	}
+}
+
+// memoryClient returns a client whose messages are handled in memory
+func memoryClient(handler func(context.Context, *A2AMessage) (*A2AResponse, error), configure func(*A2AClientConfig)) *A2AClient {
+	config := &A2AClientConfig{BaseURL: "http://a2a.test", Transport: &MemoryTransport{Handler: handler}}
+	if configure != nil {
+		configure(config)
+	}
+	return NewA2AClient(config)
+}
+
+// fastRetries returns a retry policy making up to maxRetries retries a
+// millisecond apart
+func fastRetries(maxRetries int) *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries:      maxRetries,
+		BackoffStrategy: "linear",
+		BaseDelay:       time.Millisecond,
+		MaxDelay:        time.Millisecond,
+		RetryableErrors: append([]string(nil), DefaultRetryableErrors...),
+	}
+}
+
+// okResponse is the body of a successful HTTP reply
+const okResponse = `{"message_id":"m","success":true,"result":{"ok":true}}`
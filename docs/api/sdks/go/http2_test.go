@@ -0,0 +1,51 @@
+package a2aclient
+
+import (
+	"context"
+	"encoding/pem"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestHTTP2Negotiation(t *testing.T) {
+	protos := make(chan string, 1)
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		protos <- r.Proto
+		io.WriteString(w, okResponse)
+	}))
+	server.EnableHTTP2 = true
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	server.StartTLS()
+	defer server.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(caFile, caPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		disable bool
+		want    string
+	}{
+		{false, "HTTP/2.0"},
+		{true, "HTTP/1.1"},
+	} {
+		client := NewA2AClient(&A2AClientConfig{
+			BaseURL:      server.URL,
+			Certificate:  &A2ACertificate{CAFile: caFile},
+			DisableHTTP2: tt.disable,
+		})
+		if _, err := client.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowAgentList, nil)); err != nil {
+			t.Fatalf("DisableHTTP2 %v: SendMessage: %v", tt.disable, err)
+		}
+		if proto := <-protos; proto != tt.want {
+			t.Errorf("DisableHTTP2 %v: server saw %s, want %s", tt.disable, proto, tt.want)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package a2aclient
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// recordingTransport is a RoundTripper that records every request and
+// answers with a canned response instead of contacting a server
+type recordingTransport struct {
+	mu       sync.Mutex
+	requests []*http.Request
+	bodies   [][]byte
+	status   int
+	body     string
+}
+
+func (rt *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body.Close()
+	rt.mu.Lock()
+	rt.requests = append(rt.requests, req)
+	rt.bodies = append(rt.bodies, body)
+	rt.mu.Unlock()
+
+	return &http.Response{
+		StatusCode: rt.status,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(rt.body)),
+		Request:    req,
+	}, nil
+}
+
+func TestHTTPTransportRecordsRequests(t *testing.T) {
+	recorder := &recordingTransport{status: http.StatusOK, body: `{"message_id":"m","success":true,"result":{"canned":true}}`}
+	client := NewA2AClient(&A2AClientConfig{
+		BaseURL:       "http://a2a.test",
+		APIKey:        "key-1",
+		RetryPolicy:   fastRetries(0),
+		HTTPTransport: recorder,
+	})
+
+	message := directMessage(MCPToolClaudeFlowSwarmStatus, map[string]interface{}{"swarmId": "s-1"})
+	response, err := client.SendMessage(context.Background(), message)
+	if err != nil {
+		t.Fatalf("SendMessage: %v", err)
+	}
+	if result, _ := response.Result.(map[string]interface{}); result["canned"] != true {
+		t.Errorf("result %v, want the canned response", response.Result)
+	}
+
+	if len(recorder.requests) != 1 {
+		t.Fatalf("recorded %d requests, want 1", len(recorder.requests))
+	}
+	req := recorder.requests[0]
+	if req.Method != http.MethodPost || req.URL.Host != "a2a.test" {
+		t.Errorf("request %s %s, want a POST to a2a.test", req.Method, req.URL)
+	}
+	if req.Header.Get("X-API-Key") != "key-1" || req.Header.Get("Content-Type") != "application/json" {
+		t.Errorf("headers %v", req.Header)
+	}
+	var sent A2AMessage
+	if err := json.Unmarshal(recorder.bodies[0], &sent); err != nil {
+		t.Fatalf("request body: %v", err)
+	}
+	if sent.ID != message.ID || sent.Parameters["swarmId"] != "s-1" {
+		t.Errorf("sent %+v, want the message", sent)
+	}
+}
+
+func TestHTTPTransportCannedFailure(t *testing.T) {
+	recorder := &recordingTransport{status: http.StatusTooManyRequests}
+	client := NewA2AClient(&A2AClientConfig{BaseURL: "http://a2a.test", RetryPolicy: fastRetries(2), HTTPTransport: recorder})
+
+	if _, err := client.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowSwarmStatus, nil)); !HasCode(err, CodeRateLimited) {
+		t.Fatalf("got %v, want RATE_LIMITED", err)
+	}
+	if len(recorder.requests) != 3 {
+		t.Errorf("recorded %d requests, want the first attempt and 2 retries", len(recorder.requests))
+	}
+}
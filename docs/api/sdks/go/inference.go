@@ -0,0 +1,255 @@
+package a2aclient
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// streamBufferSize is the number of undelivered stream responses buffered
+// per correlation ID before further responses are dropped
+const streamBufferSize = 256
+
+// InferenceConfig represents a streamed inference request
+type InferenceConfig struct {
+	ModelID    string
+	Input      interface{}
+	Parameters map[string]interface{}
+	Target     *AgentTarget // defaults to a neural-trainer group
+}
+
+// Stopper stops a streaming inference and reports how the stream ended
+type Stopper struct {
+	client  *A2AClient
+	message *A2AMessage
+	link    *wsLink // the connection carrying the stream
+	cancel  context.CancelFunc
+	mu      sync.Mutex
+	stopped bool
+	err     error
+	done    chan struct{}
+}
+
+// Stop asks the server to halt generation for the inference's correlation
+// ID and closes the stream. The stream then ends with a STOPPED error rather
+// than a failure. Calling Stop after the stream has finished is a no-op.
+func (s *Stopper) Stop() error {
+	s.mu.Lock()
+	select {
+	case <-s.done:
+		s.mu.Unlock()
+		return nil
+	default:
+	}
+	if s.stopped {
+		s.mu.Unlock()
+		return nil
+	}
+	s.stopped = true
+	s.mu.Unlock()
+
+	err := s.client.sendControl(s.link, s.message, "cancel")
+	s.cancel()
+	return err
+}
+
+// Stopped reports whether the stream was stopped by the caller
+func (s *Stopper) Stopped() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stopped
+}
+
+// Done is closed once the stream has ended
+func (s *Stopper) Done() <-chan struct{} {
+	return s.done
+}
+
+// Err returns how the stream ended once Done is closed: nil on normal
+// completion, a STOPPED error if Stop was called, or the failure otherwise
+func (s *Stopper) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// finish records the stream outcome and closes Done
+func (s *Stopper) finish(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stopped {
+		err = NewA2AClientError(CodeStopped, "Inference stopped by caller", s.message.CorrelationID)
+	}
+	s.err = err
+	close(s.done)
+}
+
+// StreamInference runs an inference and streams every partial response on
+// the returned channel until the server sends the final response. The
+// Stopper halts generation server-side without closing the connection.
+// Streaming requires an open WebSocket connection.
+func (c *A2AClient) StreamInference(ctx context.Context, config InferenceConfig) (<-chan *A2AResponse, *Stopper, error) {
+	target := AgentTarget{
+		GroupTarget: &GroupTarget{
+			Type:              "group",
+			Role:              AgentRoleNeuralTrainer,
+			MaxAgents:         intPtr(1),
+			SelectionStrategy: "load-balanced",
+		},
+	}
+	if config.Target != nil {
+		target = *config.Target
+	}
+
+	params := map[string]interface{}{
+		"modelId": config.ModelID,
+		"input":   config.Input,
+		"stream":  true,
+	}
+	for key, value := range config.Parameters {
+		params[key] = value
+	}
+
+	message := &A2AMessage{
+		Target:     target,
+		ToolName:   MCPToolClaudeFlowInferenceRun,
+		Parameters: params,
+		Coordination: CoordinationMode{
+			DirectCoordination: &DirectCoordination{
+				Mode: "direct",
+			},
+		},
+	}
+
+	ctx, done, err := c.beginStream(ctx, message)
+	if err != nil {
+		return nil, nil, err
+	}
+	streamCtx, cancel := context.WithCancel(ctx)
+	stopper := &Stopper{
+		client:  c,
+		message: message,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+
+	responses, link, err := c.openStream(streamCtx, message, func(err error) {
+		stopper.finish(done(err))
+	})
+	if err != nil {
+		cancel()
+		return nil, nil, done(err)
+	}
+	stopper.link = link
+	return responses, stopper, nil
+}
+
+// openStream sends a message over a pooled WebSocket connection and forwards
+// every response sharing its correlation ID until a final response arrives or
+// ctx is done. onDone is called with the stream's terminal error (nil on
+// completion) after the returned channel is closed. The connection carrying
+// the stream is returned too. The message must already have been through
+// beginStream.
+func (c *A2AClient) openStream(ctx context.Context, message *A2AMessage, onDone func(error)) (<-chan *A2AResponse, *wsLink, error) {
+	link := c.nextLink()
+	if link == nil {
+		return nil, nil, NewA2AClientError(CodeWebSocketRequired, "Streaming requires an open WebSocket connection", nil)
+	}
+
+	if message.CorrelationID == "" {
+		message.CorrelationID = message.ID
+	}
+
+	incoming := make(chan *A2AResponse, streamBufferSize)
+	unregister, err := c.registerPending(message.ID, message.CorrelationID, incoming, time.Time{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	buf, err := c.encodeMessage(message)
+	if err != nil {
+		unregister()
+		return nil, nil, fmt.Errorf("failed to marshal message: %w", err)
+	}
+	err = link.write(c.frameType(), buf.Bytes())
+	releaseBuffer(buf)
+	if err != nil {
+		unregister()
+		return nil, nil, fmt.Errorf("failed to send WebSocket message: %w", err)
+	}
+
+	out := make(chan *A2AResponse)
+	go func() {
+		var streamErr error
+		defer func() {
+			unregister()
+			close(out)
+			onDone(streamErr)
+		}()
+
+		for {
+			select {
+			case response := <-incoming:
+				select {
+				case out <- response:
+				case <-ctx.Done():
+					streamErr = ctx.Err()
+					return
+				}
+				if response.Final {
+					streamErr = responseError(response)
+					return
+				}
+			case <-link.done:
+				streamErr = NewA2AClientError(CodeConnectionLost, "WebSocket connection lost before the stream finished", message.CorrelationID)
+				return
+			case <-ctx.Done():
+				streamErr = ctx.Err()
+				return
+			}
+		}
+	}()
+
+	return out, link, nil
+}
+
+// sendControl sends a control action ("cancel", "unsubscribe") for the
+// message with the given correlation ID on link, the connection the message
+// was sent on, or on any pooled connection if that one is gone. Control
+// messages are fire-and-forget.
+func (c *A2AClient) sendControl(link *wsLink, message *A2AMessage, action string) error {
+	if link == nil || !link.alive() {
+		link = c.nextLink()
+	}
+	if link == nil {
+		return NewA2AClientError(CodeWebSocketRequired, "Control messages require an open WebSocket connection", nil)
+	}
+
+	control := &A2AMessage{
+		ID:       c.generateMessageID(),
+		Target:   message.Target,
+		ToolName: message.ToolName,
+		Parameters: map[string]interface{}{
+			"action":        action,
+			"correlationId": message.CorrelationID,
+		},
+		Coordination: CoordinationMode{
+			DirectCoordination: &DirectCoordination{
+				Mode: "direct",
+			},
+		},
+		Priority: messagePriorityPtr(MessagePriorityCritical),
+	}
+
+	buf, err := c.encodeMessage(control)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s message: %w", action, err)
+	}
+	err = link.write(c.frameType(), buf.Bytes())
+	releaseBuffer(buf)
+	if err != nil {
+		return fmt.Errorf("failed to send %s message: %w", action, err)
+	}
+	return nil
+}
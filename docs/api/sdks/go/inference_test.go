@@ -0,0 +1,54 @@
+package a2aclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestStreamInferencePreparesMessage(t *testing.T) {
+	received := make(chan *A2AMessage, 1)
+	server := newWSServer(t, func(_ *websocket.Conn, message *A2AMessage) *A2AResponse {
+		received <- message
+		response := echoResult(message)
+		response.Final = true
+		return response
+	})
+	client := connectWS(t, server, nil)
+
+	responses, stopper, err := client.StreamInference(context.Background(), InferenceConfig{ModelID: "m"})
+	if err != nil {
+		t.Fatalf("StreamInference: %v", err)
+	}
+	for range responses {
+	}
+	<-stopper.Done()
+	if err := stopper.Err(); err != nil {
+		t.Errorf("stream ended with %v", err)
+	}
+
+	message := <-received
+	if message.Timestamp == nil || message.IdempotencyKey == "" {
+		t.Errorf("inference message was not prepared: %+v", message)
+	}
+	client.lifecycleMutex.Lock()
+	n := client.inFlight
+	client.lifecycleMutex.Unlock()
+	if n != 0 {
+		t.Errorf("%d requests still in flight after the stream ended", n)
+	}
+}
+
+func TestStreamInferenceRefusedAfterShutdown(t *testing.T) {
+	server := newWSServer(t, func(*websocket.Conn, *A2AMessage) *A2AResponse { return nil })
+	client := connectWS(t, server, nil)
+	if err := client.Shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err := client.StreamInference(context.Background(), InferenceConfig{ModelID: "m"})
+	if !HasCode(err, CodeClientShutdown) {
+		t.Errorf("got %v, want CLIENT_SHUTDOWN", err)
+	}
+}
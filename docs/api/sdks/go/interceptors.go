@@ -0,0 +1,69 @@
+package a2aclient
+
+import (
+	"context"
+	"time"
+)
+
+// SendFunc sends a message and returns its response, as SendMessage does
+type SendFunc func(ctx context.Context, message *A2AMessage) (*A2AResponse, error)
+
+// Interceptor wraps SendMessage to add cross-cutting behaviour such as
+// refreshing credentials, logging or metrics. It receives the prepared
+// message, with its ID and timestamp set, and next, which performs the rest
+// of the send: later interceptors, the response cache, queueing and every
+// retry. An interceptor may change the message or context before calling
+// next, change the response or error it returns, or return without calling
+// next at all to short-circuit the send.
+type Interceptor func(ctx context.Context, message *A2AMessage, next SendFunc) (*A2AResponse, error)
+
+// chainInterceptors wraps send in interceptors, the first outermost
+func chainInterceptors(interceptors []Interceptor, send SendFunc) SendFunc {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], send
+		send = func(ctx context.Context, message *A2AMessage) (*A2AResponse, error) {
+			return interceptor(ctx, message, next)
+		}
+	}
+	return send
+}
+
+// LoggingInterceptor logs each message with its outcome and duration to
+// logger: successes at Info, failures at Warn. Unlike the client's own
+// logging it is not filtered by LoggingConfig.Level.
+func LoggingInterceptor(logger Logger) Interceptor {
+	if logger == nil {
+		logger = NopLogger{}
+	}
+	return func(ctx context.Context, message *A2AMessage, next SendFunc) (*A2AResponse, error) {
+		start := time.Now()
+		response, err := next(ctx, message)
+		elapsed := time.Since(start)
+
+		switch {
+		case err != nil:
+			logger.Warn("message failed", "id", message.ID, "tool", message.ToolName, "error", err, "elapsed", elapsed)
+		case response != nil && !response.Success:
+			logger.Warn("message failed", "id", message.ID, "tool", message.ToolName, "code", errorCode(response, nil), "elapsed", elapsed)
+		default:
+			logger.Info("message ok", "id", message.ID, "tool", message.ToolName, "elapsed", elapsed)
+		}
+		return response, err
+	}
+}
+
+// MetricsInterceptor reports each message to hook like the Metrics config
+// option does, as seen at its place in the chain: messages an earlier
+// interceptor short-circuits are not counted
+func MetricsInterceptor(hook MetricsHook) Interceptor {
+	return func(ctx context.Context, message *A2AMessage, next SendFunc) (*A2AResponse, error) {
+		hook.ObserveRequest(message.ToolName, coordinationMode(message.Coordination))
+		start := time.Now()
+		response, err := next(ctx, message)
+		hook.ObserveLatency(message.ToolName, time.Since(start))
+		if err != nil || (response != nil && !response.Success) {
+			hook.IncError(message.ToolName, errorCode(response, err))
+		}
+		return response, err
+	}
+}
@@ -0,0 +1,60 @@
+package a2aclient
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestInterceptorOrder(t *testing.T) {
+	var calls []string
+	record := func(name string) Interceptor {
+		return func(ctx context.Context, message *A2AMessage, next SendFunc) (*A2AResponse, error) {
+			calls = append(calls, name+" before")
+			response, err := next(ctx, message)
+			calls = append(calls, name+" after")
+			return response, err
+		}
+	}
+	client := memoryClient(func(_ context.Context, message *A2AMessage) (*A2AResponse, error) {
+		calls = append(calls, "send")
+		return echoResult(message), nil
+	}, func(config *A2AClientConfig) {
+		config.Interceptors = []Interceptor{record("outer"), record("inner")}
+	})
+
+	if _, err := client.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowSwarmStatus, nil)); err != nil {
+		t.Fatalf("SendMessage: %v", err)
+	}
+	want := []string{"outer before", "inner before", "send", "inner after", "outer after"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+}
+
+func TestInterceptorShortCircuit(t *testing.T) {
+	var innerCalled bool
+	canned := &A2AResponse{MessageID: "canned", Success: true}
+	client := memoryClient(func(context.Context, *A2AMessage) (*A2AResponse, error) {
+		t.Error("short-circuited message was sent")
+		return nil, nil
+	}, func(config *A2AClientConfig) {
+		config.Interceptors = []Interceptor{
+			func(context.Context, *A2AMessage, SendFunc) (*A2AResponse, error) {
+				return canned, nil
+			},
+			func(ctx context.Context, message *A2AMessage, next SendFunc) (*A2AResponse, error) {
+				innerCalled = true
+				return next(ctx, message)
+			},
+		}
+	})
+
+	response, err := client.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowSwarmStatus, nil))
+	if err != nil || response != canned {
+		t.Fatalf("got %v, %v, want the canned response", response, err)
+	}
+	if innerCalled {
+		t.Error("interceptor after the short-circuit was called")
+	}
+}
@@ -0,0 +1,58 @@
+package a2aclient
+
+import (
+	"errors"
+	"net"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// startKeepAlive arms the read deadline and pong handler on a new connection
+// and starts pinging it every PingInterval until its reader stops. Pongs, like
+// any other frame, push the read deadline out; a connection that stays silent
+// past the deadline fails its next read and is handled as lost. It must be
+// called before the connection's reader starts.
+func (c *A2AClient) startKeepAlive(link *wsLink) {
+	c.extendReadDeadline(link)
+	if c.config.PingInterval < 0 {
+		return
+	}
+
+	link.conn.SetPongHandler(func(string) error {
+		c.extendReadDeadline(link)
+		return nil
+	})
+
+	go func() {
+		ticker := time.NewTicker(c.config.PingInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				// A failed ping surfaces as a read error through the deadline
+				link.write(websocket.PingMessage, nil)
+			case <-link.done:
+				return
+			}
+		}
+	}()
+}
+
+// extendReadDeadline records activity on the connection and gives it until
+// the next ping plus the pong timeout to produce another frame
+func (c *A2AClient) extendReadDeadline(link *wsLink) {
+	now := time.Now()
+	link.lastActivity.Store(now.UnixNano())
+	if c.config.PingInterval < 0 {
+		return
+	}
+	link.conn.SetReadDeadline(now.Add(c.config.PingInterval + c.config.PongTimeout))
+}
+
+// isStale reports whether a read error was caused by the keepalive deadline
+func isStale(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
@@ -0,0 +1,111 @@
+package a2aclient
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// probeConcurrency bounds how many agents ProbeAgentLatency pings at once
+const probeConcurrency = 8
+
+// ProbeError reports the agents that could not be reached by
+// ProbeAgentLatency
+type ProbeError struct {
+	Failures map[string]error
+}
+
+func (e *ProbeError) Error() string {
+	agentIDs := make([]string, 0, len(e.Failures))
+	for agentID := range e.Failures {
+		agentIDs = append(agentIDs, agentID)
+	}
+	sort.Strings(agentIDs)
+
+	parts := make([]string, len(agentIDs))
+	for i, agentID := range agentIDs {
+		parts[i] = fmt.Sprintf("%s: %v", agentID, e.Failures[agentID])
+	}
+	return fmt.Sprintf("%d agent(s) unreachable: %s", len(agentIDs), strings.Join(parts, "; "))
+}
+
+// ProbeAgentLatency pings each agent directly and concurrently and returns the
+// round-trip time of every agent that answered. Probes are sent once, without
+// retries, so the timings are not inflated by backoff. If any agent fails to
+// answer, the returned error is a *ProbeError listing them, alongside the
+// timings of the agents that did.
+func (c *A2AClient) ProbeAgentLatency(ctx context.Context, agentIDs []string) (map[string]time.Duration, error) {
+	latencies := make(map[string]time.Duration)
+	failures := make(map[string]error)
+	var mu sync.Mutex
+
+	sem := make(chan struct{}, probeConcurrency)
+	var wg sync.WaitGroup
+
+	for _, agentID := range agentIDs {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			mu.Lock()
+			failures[agentID] = ctx.Err()
+			mu.Unlock()
+			continue
+		}
+
+		wg.Add(1)
+		go func(agentID string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			latency, err := c.probeAgent(ctx, agentID)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				failures[agentID] = err
+				return
+			}
+			latencies[agentID] = latency
+		}(agentID)
+	}
+
+	wg.Wait()
+
+	if len(failures) > 0 {
+		return latencies, &ProbeError{Failures: failures}
+	}
+	return latencies, nil
+}
+
+// probeAgent sends a single health check ping to one agent and measures the
+// round trip. The ping goes through SendMessage, so interceptors, tracing
+// and the circuit breaker see it, but is never retried.
+func (c *A2AClient) probeAgent(ctx context.Context, agentID string) (time.Duration, error) {
+	message := &A2AMessage{
+		Target:   Utils.SingleTarget(agentID),
+		ToolName: MCPToolClaudeFlowHealthCheck,
+		Parameters: map[string]interface{}{
+			"probe": true,
+		},
+		Coordination: CoordinationMode{
+			DirectCoordination: &DirectCoordination{
+				Mode:    "direct",
+				Retries: intPtr(0),
+			},
+		},
+	}
+
+	start := time.Now()
+	response, err := c.SendMessage(withoutRetries(ctx), message)
+	latency := time.Since(start)
+	if err != nil {
+		return 0, err
+	}
+	if err := responseError(response); err != nil {
+		return 0, err
+	}
+	return latency, nil
+}
@@ -0,0 +1,34 @@
+package a2aclient
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+)
+
+func TestProbeAgentLatencyGoesThroughSendMessage(t *testing.T) {
+	var sends, intercepted int32
+	client := memoryClient(func(context.Context, *A2AMessage) (*A2AResponse, error) {
+		atomic.AddInt32(&sends, 1)
+		return nil, NewA2AClientError(CodeTimeout, "no answer", nil)
+	}, func(config *A2AClientConfig) {
+		config.RetryPolicy = fastRetries(3)
+		config.Interceptors = []Interceptor{
+			func(ctx context.Context, message *A2AMessage, next SendFunc) (*A2AResponse, error) {
+				atomic.AddInt32(&intercepted, 1)
+				return next(ctx, message)
+			},
+		}
+	})
+
+	_, err := client.ProbeAgentLatency(context.Background(), []string{"a"})
+	if _, ok := err.(*ProbeError); !ok {
+		t.Fatalf("got %v, want a *ProbeError", err)
+	}
+	if intercepted != 1 {
+		t.Errorf("interceptor saw %d probes, want 1", intercepted)
+	}
+	if sends != 1 {
+		t.Errorf("probe sent %d times, want once without retries", sends)
+	}
+}
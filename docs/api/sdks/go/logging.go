@@ -0,0 +1,127 @@
+package a2aclient
+
+import (
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// Logger receives the client's log records: a message with alternating key
+// and value pairs. *slog.Logger implements it; see SlogLogger.
+type Logger interface {
+	Debug(msg string, kv ...interface{})
+	Info(msg string, kv ...interface{})
+	Warn(msg string, kv ...interface{})
+	Error(msg string, kv ...interface{})
+}
+
+// NopLogger discards every record. It is the default Logger.
+type NopLogger struct{}
+
+func (NopLogger) Debug(string, ...interface{}) {}
+func (NopLogger) Info(string, ...interface{})  {}
+func (NopLogger) Warn(string, ...interface{})  {}
+func (NopLogger) Error(string, ...interface{}) {}
+
+// SlogLogger adapts a log/slog logger, or slog.Default() if nil, for use as
+// the client Logger
+func SlogLogger(logger *slog.Logger) Logger {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return logger
+}
+
+// logLevels ranks the LoggingConfig levels from most to least verbose
+var logLevels = map[string]int{
+	"DEBUG": 0,
+	"INFO":  1,
+	"WARN":  2,
+	"ERROR": 3,
+}
+
+// logLevelRank returns the rank of a level, treating unknown levels as INFO
+func logLevelRank(level string) int {
+	if rank, ok := logLevels[strings.ToUpper(level)]; ok {
+		return rank
+	}
+	return logLevels["INFO"]
+}
+
+// log passes a record to the configured Logger if level meets the configured
+// minimum level
+func (c *A2AClient) log(level, msg string, kv ...interface{}) {
+	if logLevelRank(level) < logLevelRank(c.config.Logging.Level) {
+		return
+	}
+	logger := c.config.Logger
+	switch strings.ToUpper(level) {
+	case "DEBUG":
+		logger.Debug(msg, kv...)
+	case "WARN":
+		logger.Warn(msg, kv...)
+	case "ERROR":
+		logger.Error(msg, kv...)
+	default:
+		logger.Info(msg, kv...)
+	}
+}
+
+// logRequest logs an outgoing message when request logging is enabled
+func (c *A2AClient) logRequest(message *A2AMessage) {
+	logging := c.config.Logging
+	if !logging.EnableRequestLogging {
+		return
+	}
+	c.log("DEBUG", "request", "id", message.ID, "tool", message.ToolName,
+		"target", targetType(message.Target), "correlation", message.CorrelationID,
+		"parameters", logging.Redaction.redactForLog(message.Parameters))
+}
+
+// logResponse logs the outcome of a message when response logging is enabled.
+// Failures are logged at WARN so they remain visible above DEBUG.
+func (c *A2AClient) logResponse(message *A2AMessage, response *A2AResponse, err error, elapsed time.Duration) {
+	if !c.config.Logging.EnableResponseLogging {
+		return
+	}
+
+	if err != nil {
+		c.log("WARN", "response", "id", message.ID, "tool", message.ToolName,
+			"error", err, "elapsed", elapsed)
+		return
+	}
+
+	level := "DEBUG"
+	errorCode := ""
+	if response.Error != nil {
+		errorCode = response.Error.Code
+	}
+	if !response.Success {
+		level = "WARN"
+	}
+	processingTime := "unknown"
+	if response.Metadata.ProcessingTime != nil {
+		processingTime = millisToDuration(response.Metadata.ProcessingTime).String()
+	}
+	c.log(level, "response", "id", message.ID, "tool", message.ToolName,
+		"success", response.Success, "error_code", errorCode,
+		"processing_time", processingTime, "elapsed", elapsed)
+}
+
+// targetType names the kind of target a message is addressed to
+func targetType(target AgentTarget) string {
+	switch {
+	case target.SingleTarget != nil:
+		return "single"
+	case target.MultipleTargets != nil:
+		return "multiple"
+	case target.GroupTarget != nil:
+		return "group"
+	case target.BroadcastTarget != nil:
+		return "broadcast"
+	case target.ConditionalTarget != nil:
+		return "conditional"
+	default:
+		return "none"
+	}
+}
@@ -0,0 +1,125 @@
+package a2aclient
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// logRecord is a record captured by capturingLogger
+type logRecord struct {
+	level string
+	msg   string
+	kv    map[string]interface{}
+}
+
+// capturingLogger is a Logger that keeps every record
+type capturingLogger struct {
+	mu      sync.Mutex
+	records []logRecord
+}
+
+func (l *capturingLogger) record(level, msg string, kv []interface{}) {
+	fields := make(map[string]interface{}, len(kv)/2)
+	for i := 0; i+1 < len(kv); i += 2 {
+		fields[kv[i].(string)] = kv[i+1]
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.records = append(l.records, logRecord{level, msg, fields})
+}
+
+func (l *capturingLogger) Debug(msg string, kv ...interface{}) { l.record("DEBUG", msg, kv) }
+func (l *capturingLogger) Info(msg string, kv ...interface{})  { l.record("INFO", msg, kv) }
+func (l *capturingLogger) Warn(msg string, kv ...interface{})  { l.record("WARN", msg, kv) }
+func (l *capturingLogger) Error(msg string, kv ...interface{}) { l.record("ERROR", msg, kv) }
+
+// find returns the first record with the given message
+func (l *capturingLogger) find(msg string) (logRecord, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, record := range l.records {
+		if record.msg == msg {
+			return record, true
+		}
+	}
+	return logRecord{}, false
+}
+
+func TestLoggerReceivesRedactedRecords(t *testing.T) {
+	logger := &capturingLogger{}
+	client := memoryClient(func(context.Context, *A2AMessage) (*A2AResponse, error) {
+		return &A2AResponse{Error: &A2AError{Code: "TOOL_FAILED", Message: "failed"}}, nil
+	}, func(config *A2AClientConfig) {
+		config.Logger = logger
+		config.Logging = &LoggingConfig{
+			Level:                 "DEBUG",
+			EnableRequestLogging:  true,
+			EnableResponseLogging: true,
+			Redaction:             &RedactionPolicy{KeyPatterns: []string{"*secret*"}},
+		}
+	})
+
+	client.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowSwarmStatus, map[string]interface{}{
+		"name":          "swarm",
+		"api_token":     "t0k3n",
+		"client_secret": "s3cr3t",
+		"nested":        map[string]interface{}{"Password": "hunter2", "count": 1},
+	}))
+
+	request, ok := logger.find("request")
+	if !ok || request.level != "DEBUG" {
+		t.Fatalf("request record %+v, want one at DEBUG", request)
+	}
+	want := map[string]interface{}{
+		"name":          "swarm",
+		"api_token":     redactedValue,
+		"client_secret": redactedValue,
+		"nested":        map[string]interface{}{"Password": redactedValue, "count": 1.0},
+	}
+	if got := request.kv["parameters"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("logged parameters %v, want %v", got, want)
+	}
+	if request.kv["tool"] != MCPToolClaudeFlowSwarmStatus || request.kv["target"] != "single" {
+		t.Errorf("request fields %v", request.kv)
+	}
+
+	response, ok := logger.find("response")
+	if !ok || response.level != "WARN" || response.kv["error_code"] != "TOOL_FAILED" {
+		t.Errorf("response record %+v, want the failure at WARN", response)
+	}
+}
+
+func TestLoggerHonoursLevel(t *testing.T) {
+	logger := &capturingLogger{}
+	client := memoryClient(nil, func(config *A2AClientConfig) {
+		config.Logger = logger
+		config.Logging = &LoggingConfig{Level: "WARN", EnableRequestLogging: true, EnableResponseLogging: true}
+	})
+
+	if _, err := client.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowSwarmStatus, nil)); err != nil {
+		t.Fatal(err)
+	}
+	if len(logger.records) != 0 {
+		t.Errorf("captured %+v, want DEBUG records dropped at WARN", logger.records)
+	}
+}
+
+func TestSlogLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := SlogLogger(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	client := memoryClient(nil, func(config *A2AClientConfig) {
+		config.Logger = logger
+		config.Logging = &LoggingConfig{Level: "DEBUG", EnableRequestLogging: true}
+	})
+
+	client.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowSwarmStatus, map[string]interface{}{"password": "hunter2"}))
+	out := buf.String()
+	if !strings.Contains(out, "level=DEBUG msg=request") || strings.Contains(out, "hunter2") {
+		t.Errorf("slog output %q, want a redacted DEBUG request record", out)
+	}
+}
@@ -0,0 +1,194 @@
+package a2aclient
+
+import (
+	"context"
+	"sync"
+)
+
+// memoryBatchConcurrency bounds the individual sends made for a group of
+// memory operations when the server does not support multi-key messages
+const memoryBatchConcurrency = 8
+
+// unsupportedBatchCodes are the error codes with which servers reject a
+// multi-key memory action they do not implement
+var unsupportedBatchCodes = map[string]bool{
+	"UNSUPPORTED_ACTION": true,
+	"NOT_IMPLEMENTED":    true,
+	"INVALID_ACTION":     true,
+}
+
+// memoryGroupKey groups memory operations that can share one message
+type memoryGroupKey struct {
+	namespace         string
+	consistency       string
+	replicationFactor int
+}
+
+// memoryGroup is a set of memory operations sent together. message carries
+// all of them; singles holds one message per operation, used when the group
+// has one operation or the server rejects the multi-key message.
+type memoryGroup struct {
+	indexes []int
+	singles []*A2AMessage
+	message *A2AMessage
+}
+
+// StoreMemoryBatch stores many keys, grouping them by namespace, consistency
+// and replication factor so each group is written with a single
+// "store_batch" message. Servers that reject multi-key messages get the
+// group's keys as individual stores instead, a few at a time. responses and
+// errs are aligned with configs; a key the server failed to store has an
+// unsuccessful response.
+func (c *A2AClient) StoreMemoryBatch(ctx context.Context, configs []MemoryStoreConfig) ([]*A2AResponse, []error) {
+	responses := make([]*A2AResponse, len(configs))
+	errs := make([]error, len(configs))
+
+	groups := make(map[memoryGroupKey]*memoryGroup)
+	for i, config := range configs {
+		consistency, err := c.resolveConsistency(config.Namespace, config.Consistency)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		key := memoryGroupKey{config.Namespace, consistency, config.ReplicationFactor}
+		group, ok := groups[key]
+		if !ok {
+			group = &memoryGroup{}
+			groups[key] = group
+		}
+		group.indexes = append(group.indexes, i)
+		group.singles = append(group.singles, storeMemoryMessage(config, consistency))
+	}
+
+	for key, group := range groups {
+		if len(group.singles) == 1 {
+			continue
+		}
+
+		entries := make([]map[string]interface{}, len(group.indexes))
+		keys := make([]string, len(group.indexes))
+		for i, index := range group.indexes {
+			config := configs[index]
+			entries[i] = map[string]interface{}{
+				"key":   config.Key,
+				"value": config.Value,
+				"ttl":   config.TTL,
+			}
+			keys[i] = config.Key
+		}
+
+		message := storeMemoryMessage(configs[group.indexes[0]], key.consistency)
+		message.Parameters = map[string]interface{}{
+			"action":    "store_batch",
+			"namespace": key.namespace,
+			"entries":   entries,
+		}
+		message.StateRequirements[0].Keys = keys
+		group.message = message
+	}
+
+	c.sendMemoryGroups(ctx, groups, responses, errs)
+	return responses, errs
+}
+
+// RetrieveMemoryBatch retrieves many keys, grouping them by namespace and
+// consistency so each group is read with a single "retrieve_batch" message.
+// Servers that reject multi-key messages get the group's keys as individual
+// retrieves instead, a few at a time. responses and errs are aligned with
+// configs; a key the server failed to read has an unsuccessful response.
+func (c *A2AClient) RetrieveMemoryBatch(ctx context.Context, configs []MemoryRetrieveConfig) ([]*A2AResponse, []error) {
+	responses := make([]*A2AResponse, len(configs))
+	errs := make([]error, len(configs))
+
+	groups := make(map[memoryGroupKey]*memoryGroup)
+	keys := make(map[memoryGroupKey][]string)
+	for i, config := range configs {
+		consistency, err := c.resolveConsistency(config.Namespace, config.Consistency)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		key := memoryGroupKey{namespace: config.Namespace, consistency: consistency}
+		group, ok := groups[key]
+		if !ok {
+			group = &memoryGroup{}
+			groups[key] = group
+		}
+		group.indexes = append(group.indexes, i)
+		group.singles = append(group.singles, retrieveMemoryMessage(config.Namespace, []string{config.Key}, consistency))
+		keys[key] = append(keys[key], config.Key)
+	}
+
+	for key, group := range groups {
+		if len(group.singles) > 1 {
+			group.message = retrieveMemoryMessage(key.namespace, keys[key], key.consistency)
+		}
+	}
+
+	c.sendMemoryGroups(ctx, groups, responses, errs)
+	return responses, errs
+}
+
+// sendMemoryGroups sends every group concurrently and fills in the
+// responses and errors of its operations
+func (c *A2AClient) sendMemoryGroups(ctx context.Context, groups map[memoryGroupKey]*memoryGroup, responses []*A2AResponse, errs []error) {
+	var wg sync.WaitGroup
+	for _, group := range groups {
+		wg.Add(1)
+		go func(group *memoryGroup) {
+			defer wg.Done()
+
+			if group.message != nil {
+				response, err := c.SendMessage(ctx, group.message)
+				if err != nil || response.Success || response.Error == nil || !unsupportedBatchCodes[response.Error.Code] {
+					for i, index := range group.indexes {
+						responses[index], errs[index] = splitBatchResponse(response, err, i, len(group.indexes))
+					}
+					return
+				}
+				// Multi-key messages are not supported; fall back to singles
+			}
+
+			groupResponses, groupErrs := c.SendBatch(ctx, group.singles, memoryBatchConcurrency)
+			for i, index := range group.indexes {
+				responses[index], errs[index] = groupResponses[i], groupErrs[i]
+			}
+		}(group)
+	}
+	wg.Wait()
+}
+
+// splitBatchResponse returns the response for operation i of n from a
+// multi-key memory response, whose result lists per-operation outcomes
+// under "results" in request order. A failed or malformed batch response
+// applies to every operation.
+func splitBatchResponse(response *A2AResponse, err error, i, n int) (*A2AResponse, error) {
+	if err != nil || !response.Success {
+		return response, err
+	}
+
+	var result struct {
+		Results []map[string]interface{} `json:"results"`
+	}
+	if err := decodeMap(response.Result, &result); err != nil || len(result.Results) != n {
+		return nil, NewA2AClientError(CodeDecode, "Batch memory response does not have one result per key", response.MessageID)
+	}
+
+	item := result.Results[i]
+	split := *response
+	split.Result = item
+	if value, ok := item["result"]; ok {
+		split.Result = value
+	}
+	if raw, ok := item["error"]; ok && raw != nil {
+		var itemErr A2AError
+		if err := decodeMap(raw, &itemErr); err == nil {
+			split.Error = &itemErr
+			split.Success = false
+		}
+	}
+	if success, ok := item["success"].(bool); ok {
+		split.Success = success
+	}
+	return &split, nil
+}
@@ -0,0 +1,96 @@
+package a2aclient
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestStoreMemoryBatchGroupsByNamespace(t *testing.T) {
+	var mu sync.Mutex
+	sent := make(map[string][]string)
+	client := memoryClient(func(_ context.Context, message *A2AMessage) (*A2AResponse, error) {
+		params := message.Parameters
+		namespace := params["namespace"].(string)
+		if params["action"] != "store_batch" {
+			mu.Lock()
+			sent[namespace] = append(sent[namespace], params["key"].(string))
+			mu.Unlock()
+			return &A2AResponse{MessageID: message.ID, Success: true, Result: "stored"}, nil
+		}
+
+		var keys []string
+		var results []interface{}
+		for _, entry := range params["entries"].([]interface{}) {
+			key := entry.(map[string]interface{})["key"].(string)
+			keys = append(keys, key)
+			if key == "bad" {
+				results = append(results, map[string]interface{}{
+					"success": false,
+					"error":   map[string]interface{}{"code": "QUOTA_EXCEEDED", "message": "namespace full"},
+				})
+				continue
+			}
+			results = append(results, map[string]interface{}{"success": true, "result": key})
+		}
+		mu.Lock()
+		sent[namespace] = append(sent[namespace], "batch:"+strings.Join(keys, ","))
+		mu.Unlock()
+		return &A2AResponse{MessageID: message.ID, Success: true, Result: map[string]interface{}{"results": results}}, nil
+	}, nil)
+
+	configs := []MemoryStoreConfig{
+		{Namespace: "a", Key: "one", Value: 1},
+		{Namespace: "b", Key: "solo", Value: 2},
+		{Namespace: "a", Key: "bad", Value: 3},
+		{Namespace: "a", Key: "three", Value: 4},
+	}
+	responses, errs := client.StoreMemoryBatch(context.Background(), configs)
+
+	want := map[string][]string{"a": {"batch:one,bad,three"}, "b": {"solo"}}
+	if !reflect.DeepEqual(sent, want) {
+		t.Errorf("sent %v, want %v", sent, want)
+	}
+	for i, config := range configs {
+		if errs[i] != nil {
+			t.Fatalf("%s: %v", config.Key, errs[i])
+		}
+	}
+	if responses[0].Result != "one" || responses[3].Result != "three" || responses[1].Result != "stored" {
+		t.Errorf("results %v, %v, %v are not aligned with their keys", responses[0].Result, responses[1].Result, responses[3].Result)
+	}
+	if bad := responses[2]; bad.Success || bad.Error == nil || bad.Error.Code != "QUOTA_EXCEEDED" {
+		t.Errorf("failed key got %+v, want an unsuccessful response with its error", bad)
+	}
+}
+
+func TestRetrieveMemoryBatchFallsBackToSingles(t *testing.T) {
+	var mu sync.Mutex
+	var singles []string
+	client := memoryClient(func(_ context.Context, message *A2AMessage) (*A2AResponse, error) {
+		if message.Parameters["action"] == "retrieve_batch" {
+			return &A2AResponse{MessageID: message.ID, Error: &A2AError{Code: "UNSUPPORTED_ACTION", Message: "no batches"}}, nil
+		}
+		key := message.Parameters["key"].(string)
+		mu.Lock()
+		singles = append(singles, key)
+		mu.Unlock()
+		return &A2AResponse{MessageID: message.ID, Success: true, Result: "value-" + key}, nil
+	}, nil)
+
+	configs := []MemoryRetrieveConfig{{Namespace: "a", Key: "x"}, {Namespace: "a", Key: "y"}}
+	responses, errs := client.RetrieveMemoryBatch(context.Background(), configs)
+
+	sort.Strings(singles)
+	if !reflect.DeepEqual(singles, []string{"x", "y"}) {
+		t.Errorf("individual retrieves %v, want x and y", singles)
+	}
+	for i, config := range configs {
+		if errs[i] != nil || responses[i].Result != "value-"+config.Key {
+			t.Errorf("%s: got %v, %v", config.Key, responses[i], errs[i])
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package a2aclient
+
+import (
+	"errors"
+	"time"
+)
+
+// MetricsHook receives client metrics. Implementations must be safe for
+// concurrent use. The prommetrics package provides a Prometheus adapter.
+type MetricsHook interface {
+	// ObserveRequest is called once per SendMessage call
+	ObserveRequest(tool MCPToolName, coordinationMode string)
+	// ObserveRetry is called before each retry with its 1-based number
+	ObserveRetry(tool MCPToolName, attempt int)
+	// ObserveLatency reports the end-to-end SendMessage duration, retries
+	// and backoff included
+	ObserveLatency(tool MCPToolName, latency time.Duration)
+	// IncError is called when SendMessage fails or returns an unsuccessful
+	// response, with the error code
+	IncError(tool MCPToolName, code string)
+	// ObserveInFlight reports the number of WebSocket requests, streams and
+	// subscriptions awaiting responses whenever it changes
+	ObserveInFlight(count int)
+}
+
+// coordinationMode names the coordination mode a message uses
+func coordinationMode(coordination CoordinationMode) string {
+	switch {
+	case coordination.DirectCoordination != nil:
+		return "direct"
+	case coordination.BroadcastCoordination != nil:
+		return "broadcast"
+	case coordination.ConsensusCoordination != nil:
+		return "consensus"
+	case coordination.PipelineCoordination != nil:
+		return "pipeline"
+	default:
+		return "none"
+	}
+}
+
+// errorCode returns the code to report for a failed send
+func errorCode(response *A2AResponse, err error) string {
+	if err != nil {
+		var clientErr *A2AClientError
+		if errors.As(err, &clientErr) {
+			return clientErr.Code
+		}
+		return "UNKNOWN"
+	}
+	if response != nil && response.Error != nil {
+		return response.Error.Code
+	}
+	return "UNKNOWN"
+}
+
+func (c *A2AClient) observeRequest(message *A2AMessage) {
+	if c.config.Metrics != nil {
+		c.config.Metrics.ObserveRequest(message.ToolName, coordinationMode(message.Coordination))
+	}
+}
+
+func (c *A2AClient) observeRetry(message *A2AMessage, attempt int) {
+	if c.config.Metrics != nil {
+		c.config.Metrics.ObserveRetry(message.ToolName, attempt)
+	}
+}
+
+// observeResult reports the latency and any error of a finished SendMessage
+func (c *A2AClient) observeResult(message *A2AMessage, start time.Time, response **A2AResponse, err *error) {
+	if c.config.Metrics == nil {
+		return
+	}
+	c.config.Metrics.ObserveLatency(message.ToolName, time.Since(start))
+	if *err != nil || (*response != nil && !(*response).Success) {
+		c.config.Metrics.IncError(message.ToolName, errorCode(*response, *err))
+	}
+}
+
+func (c *A2AClient) observeInFlight(count int) {
+	if c.config.Metrics != nil {
+		c.config.Metrics.ObserveInFlight(count)
+	}
+}
@@ -0,0 +1,405 @@
+// Package msgpack provides a MessagePack a2aclient.Codec. Values are encoded
+// through their JSON form, so the client's json struct tags and custom
+// marshalers apply unchanged and only the wire representation is more
+// compact. Binary strings decode as base64 strings, the way encoding/json
+// represents []byte.
+package msgpack
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+)
+
+// Codec implements a2aclient.Codec with MessagePack
+type Codec struct{}
+
+// Marshal encodes v as MessagePack
+func (Codec) Marshal(v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var tree interface{}
+	if err := decoder.Decode(&tree); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := encode(&buf, tree); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Unmarshal decodes MessagePack data into v
+func (Codec) Unmarshal(data []byte, v interface{}) error {
+	d := &decoder{data: data}
+	tree, err := d.value()
+	if err != nil {
+		return err
+	}
+	if d.pos != len(data) {
+		return fmt.Errorf("msgpack: %d trailing bytes", len(data)-d.pos)
+	}
+
+	jsonData, err := json.Marshal(tree)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(jsonData, v)
+}
+
+// UnmarshalPrefix decodes as much of a truncated value as is complete into
+// v, implementing a2aclient.PrefixUnmarshaler. Map entries cut off by the
+// end of data are dropped.
+func (Codec) UnmarshalPrefix(data []byte, v interface{}) error {
+	d := &decoder{data: data, partial: true}
+	tree, err := d.value()
+	if err != nil && (err != errShort || tree == nil) {
+		return err
+	}
+
+	jsonData, err := json.Marshal(tree)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(jsonData, v)
+}
+
+// ContentType returns "application/msgpack"
+func (Codec) ContentType() string {
+	return "application/msgpack"
+}
+
+// encode writes a value decoded from JSON with UseNumber
+func encode(buf *bytes.Buffer, v interface{}) error {
+	switch v := v.(type) {
+	case nil:
+		buf.WriteByte(0xc0)
+	case bool:
+		if v {
+			buf.WriteByte(0xc3)
+		} else {
+			buf.WriteByte(0xc2)
+		}
+	case json.Number:
+		return encodeNumber(buf, v)
+	case string:
+		encodeString(buf, v)
+	case []interface{}:
+		writeHeader(buf, len(v), 0x90, 15, 0xdc, 0xdd)
+		for _, item := range v {
+			if err := encode(buf, item); err != nil {
+				return err
+			}
+		}
+	case map[string]interface{}:
+		// Sorted so that equal values encode identically
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		writeHeader(buf, len(v), 0x80, 15, 0xde, 0xdf)
+		for _, key := range keys {
+			encodeString(buf, key)
+			if err := encode(buf, v[key]); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("msgpack: unexpected %T", v)
+	}
+	return nil
+}
+
+// encodeNumber writes a JSON number as the smallest integer that holds it,
+// or a float64 if it is not an integer
+func encodeNumber(buf *bytes.Buffer, n json.Number) error {
+	if i, err := strconv.ParseInt(string(n), 10, 64); err == nil {
+		encodeInt(buf, i)
+		return nil
+	}
+	if u, err := strconv.ParseUint(string(n), 10, 64); err == nil {
+		encodeUint(buf, u)
+		return nil
+	}
+	f, err := strconv.ParseFloat(string(n), 64)
+	if err != nil {
+		return fmt.Errorf("msgpack: invalid number %q", n)
+	}
+	buf.WriteByte(0xcb)
+	binary.Write(buf, binary.BigEndian, math.Float64bits(f))
+	return nil
+}
+
+func encodeInt(buf *bytes.Buffer, i int64) {
+	switch {
+	case i >= 0 && i <= 0x7f:
+		buf.WriteByte(byte(i))
+	case i < 0 && i >= -32:
+		buf.WriteByte(byte(int8(i)))
+	case i > 0:
+		encodeUint(buf, uint64(i))
+	case i >= math.MinInt8 && i <= math.MaxInt8:
+		buf.WriteByte(0xd0)
+		buf.WriteByte(byte(int8(i)))
+	case i >= math.MinInt16 && i <= math.MaxInt16:
+		buf.WriteByte(0xd1)
+		binary.Write(buf, binary.BigEndian, int16(i))
+	case i >= math.MinInt32 && i <= math.MaxInt32:
+		buf.WriteByte(0xd2)
+		binary.Write(buf, binary.BigEndian, int32(i))
+	default:
+		buf.WriteByte(0xd3)
+		binary.Write(buf, binary.BigEndian, i)
+	}
+}
+
+func encodeUint(buf *bytes.Buffer, u uint64) {
+	switch {
+	case u <= math.MaxUint8:
+		buf.WriteByte(0xcc)
+		buf.WriteByte(byte(u))
+	case u <= math.MaxUint16:
+		buf.WriteByte(0xcd)
+		binary.Write(buf, binary.BigEndian, uint16(u))
+	case u <= math.MaxUint32:
+		buf.WriteByte(0xce)
+		binary.Write(buf, binary.BigEndian, uint32(u))
+	default:
+		buf.WriteByte(0xcf)
+		binary.Write(buf, binary.BigEndian, u)
+	}
+}
+
+func encodeString(buf *bytes.Buffer, s string) {
+	if len(s) <= 31 {
+		buf.WriteByte(0xa0 | byte(len(s)))
+	} else if len(s) <= math.MaxUint8 {
+		buf.WriteByte(0xd9)
+		buf.WriteByte(byte(len(s)))
+	} else {
+		writeHeader(buf, len(s), 0, -1, 0xda, 0xdb)
+	}
+	buf.WriteString(s)
+}
+
+// writeHeader writes the length header of an array, map or string: the fix
+// form for lengths up to fixMax (none if negative), then the 16 and 32-bit
+// forms
+func writeHeader(buf *bytes.Buffer, n int, fix byte, fixMax int, code16, code32 byte) {
+	switch {
+	case n <= fixMax:
+		buf.WriteByte(fix | byte(n))
+	case n <= math.MaxUint16:
+		buf.WriteByte(code16)
+		binary.Write(buf, binary.BigEndian, uint16(n))
+	default:
+		buf.WriteByte(code32)
+		binary.Write(buf, binary.BigEndian, uint32(n))
+	}
+}
+
+var errShort = errors.New("msgpack: unexpected end of data")
+
+// decoder reads MessagePack into the values encoding/json produces when
+// decoding into an interface{}
+type decoder struct {
+	data []byte
+	pos  int
+	// partial makes maps that run past the end of data return the entries
+	// decoded so far along with errShort
+	partial bool
+}
+
+func (d *decoder) next(n int) ([]byte, error) {
+	if n < 0 || n > len(d.data)-d.pos {
+		return nil, errShort
+	}
+	b := d.data[d.pos : d.pos+n]
+	d.pos += n
+	return b, nil
+}
+
+// length reads a big-endian length of size bytes
+func (d *decoder) length(size int) (int, error) {
+	b, err := d.next(size)
+	if err != nil {
+		return 0, err
+	}
+	switch size {
+	case 1:
+		return int(b[0]), nil
+	case 2:
+		return int(binary.BigEndian.Uint16(b)), nil
+	default:
+		n := binary.BigEndian.Uint32(b)
+		if uint64(n) > uint64(len(d.data)) {
+			return 0, errShort
+		}
+		return int(n), nil
+	}
+}
+
+func (d *decoder) value() (interface{}, error) {
+	b, err := d.next(1)
+	if err != nil {
+		return nil, err
+	}
+	code := b[0]
+
+	switch {
+	case code <= 0x7f:
+		return json.Number(strconv.Itoa(int(code))), nil
+	case code >= 0xe0:
+		return json.Number(strconv.Itoa(int(int8(code)))), nil
+	case code&0xf0 == 0x80:
+		return d.mapValue(int(code & 0x0f))
+	case code&0xf0 == 0x90:
+		return d.array(int(code & 0x0f))
+	case code&0xe0 == 0xa0:
+		return d.str(int(code & 0x1f))
+	}
+
+	switch code {
+	case 0xc0:
+		return nil, nil
+	case 0xc2:
+		return false, nil
+	case 0xc3:
+		return true, nil
+	case 0xc4, 0xc5, 0xc6:
+		n, err := d.length(1 << (code - 0xc4))
+		if err != nil {
+			return nil, err
+		}
+		data, err := d.next(n)
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte(nil), data...), nil
+	case 0xca:
+		b, err := d.next(4)
+		if err != nil {
+			return nil, err
+		}
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(b))), nil
+	case 0xcb:
+		b, err := d.next(8)
+		if err != nil {
+			return nil, err
+		}
+		return math.Float64frombits(binary.BigEndian.Uint64(b)), nil
+	case 0xcc, 0xcd, 0xce, 0xcf:
+		size := 1 << (code - 0xcc)
+		b, err := d.next(size)
+		if err != nil {
+			return nil, err
+		}
+		var u uint64
+		for _, c := range b {
+			u = u<<8 | uint64(c)
+		}
+		return json.Number(strconv.FormatUint(u, 10)), nil
+	case 0xd0, 0xd1, 0xd2, 0xd3:
+		size := 1 << (code - 0xd0)
+		b, err := d.next(size)
+		if err != nil {
+			return nil, err
+		}
+		var u uint64
+		for _, c := range b {
+			u = u<<8 | uint64(c)
+		}
+		// Sign-extend from the encoded width
+		shift := 64 - 8*uint(size)
+		return json.Number(strconv.FormatInt(int64(u<<shift)>>shift, 10)), nil
+	case 0xd9, 0xda, 0xdb:
+		n, err := d.length(1 << (code - 0xd9))
+		if err != nil {
+			return nil, err
+		}
+		return d.str(n)
+	case 0xdc, 0xdd:
+		n, err := d.length(2 << (code - 0xdc))
+		if err != nil {
+			return nil, err
+		}
+		return d.array(n)
+	case 0xde, 0xdf:
+		n, err := d.length(2 << (code - 0xde))
+		if err != nil {
+			return nil, err
+		}
+		return d.mapValue(n)
+	}
+	return nil, fmt.Errorf("msgpack: unsupported type 0x%02x", code)
+}
+
+func (d *decoder) str(n int) (interface{}, error) {
+	b, err := d.next(n)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+func (d *decoder) array(n int) (interface{}, error) {
+	// Every element takes at least a byte
+	if n > len(d.data)-d.pos {
+		return nil, errShort
+	}
+	items := make([]interface{}, n)
+	for i := range items {
+		item, err := d.value()
+		if err != nil {
+			return nil, err
+		}
+		items[i] = item
+	}
+	return items, nil
+}
+
+func (d *decoder) mapValue(n int) (interface{}, error) {
+	// Every entry takes at least two bytes
+	size := n
+	if n > (len(d.data)-d.pos)/2 {
+		if !d.partial {
+			return nil, errShort
+		}
+		size = (len(d.data) - d.pos) / 2
+	}
+	entries := make(map[string]interface{}, size)
+	for i := 0; i < n; i++ {
+		key, err := d.value()
+		if err == errShort && d.partial {
+			return entries, err
+		}
+		if err != nil {
+			return nil, err
+		}
+		name, ok := key.(string)
+		if !ok {
+			return nil, fmt.Errorf("msgpack: map key of type %T, want string", key)
+		}
+		value, err := d.value()
+		if err == errShort && d.partial {
+			return entries, err
+		}
+		if err != nil {
+			return nil, err
+		}
+		entries[name] = value
+	}
+	return entries, nil
+}
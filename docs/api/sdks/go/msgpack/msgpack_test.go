@@ -0,0 +1,170 @@
+package msgpack
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	a2aclient "github.com/gemini-flow/a2a-client-go"
+)
+
+type sample struct {
+	Nested  map[string]interface{} `json:"nested"`
+	Binary  []byte                 `json:"binary"`
+	Int     int64                  `json:"int"`
+	Uint    uint64                 `json:"uint"`
+	Float   float64                `json:"float"`
+	Strings []string               `json:"strings"`
+}
+
+func TestRoundTripMatchesJSON(t *testing.T) {
+	in := sample{
+		Nested: map[string]interface{}{
+			"a": map[string]interface{}{
+				"b": []interface{}{"c", true, nil, 1.5},
+				"d": map[string]interface{}{},
+			},
+		},
+		Binary: []byte{0x00, 0xff, 0xc4, 0x80},
+		Int:    math.MinInt64,
+		// Above 2^53, where a float64 would lose precision
+		Uint:    math.MaxUint64,
+		Float:   -1.25e300,
+		Strings: []string{"", "x", strings.Repeat("y", 70000)},
+	}
+
+	for _, codec := range []a2aclient.Codec{a2aclient.JSONCodec{}, Codec{}} {
+		data, err := codec.Marshal(in)
+		if err != nil {
+			t.Fatalf("%T.Marshal: %v", codec, err)
+		}
+		var out sample
+		if err := codec.Unmarshal(data, &out); err != nil {
+			t.Fatalf("%T.Unmarshal: %v", codec, err)
+		}
+		if !reflect.DeepEqual(out, in) {
+			t.Errorf("%T round trip = %+v, want %+v", codec, out, in)
+		}
+
+		// Untyped values decode the same way through either codec
+		var tree interface{}
+		if err := codec.Unmarshal(data, &tree); err != nil {
+			t.Fatalf("%T.Unmarshal into interface{}: %v", codec, err)
+		}
+		jsonData, _ := a2aclient.JSONCodec{}.Marshal(in)
+		var want interface{}
+		a2aclient.JSONCodec{}.Unmarshal(jsonData, &want)
+		if !reflect.DeepEqual(tree, want) {
+			t.Errorf("%T untyped round trip = %v, want %v", codec, tree, want)
+		}
+	}
+}
+
+func TestStringHeaders(t *testing.T) {
+	tests := []struct {
+		length int
+		header []byte
+	}{
+		{31, []byte{0xbf}},
+		{32, []byte{0xd9, 32}},
+		{255, []byte{0xd9, 0xff}},
+		{256, []byte{0xda, 0x01, 0x00}},
+		{65535, []byte{0xda, 0xff, 0xff}},
+		{65536, []byte{0xdb, 0x00, 0x01, 0x00, 0x00}},
+	}
+	for _, tt := range tests {
+		s := strings.Repeat("s", tt.length)
+		data, err := Codec{}.Marshal(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.HasPrefix(data, tt.header) || len(data) != len(tt.header)+tt.length {
+			t.Errorf("length %d: header % x, want % x", tt.length, data[:len(tt.header)], tt.header)
+		}
+
+		var out string
+		if err := (Codec{}).Unmarshal(data, &out); err != nil || out != s {
+			t.Errorf("length %d: decoded %d bytes, err %v", tt.length, len(out), err)
+		}
+	}
+}
+
+func TestIntegerEncodings(t *testing.T) {
+	tests := []struct {
+		value  int64
+		header byte
+	}{
+		{127, 0x7f},
+		{-32, 0xe0},
+		{-33, 0xd0},
+		{255, 0xcc},
+		{math.MinInt16, 0xd1},
+		{math.MaxUint32, 0xce},
+		{math.MinInt32, 0xd2},
+		{math.MaxInt64, 0xcf},
+		{math.MinInt64, 0xd3},
+	}
+	for _, tt := range tests {
+		data, err := Codec{}.Marshal(tt.value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if data[0] != tt.header {
+			t.Errorf("%d: header 0x%02x, want 0x%02x", tt.value, data[0], tt.header)
+		}
+		var out int64
+		if err := (Codec{}).Unmarshal(data, &out); err != nil || out != tt.value {
+			t.Errorf("%d: decoded %d, err %v", tt.value, out, err)
+		}
+	}
+}
+
+func TestUnmarshalBinaryAsBase64(t *testing.T) {
+	// {"binary": bin8[0x01 0x02]}
+	data := []byte{0x81, 0xa6, 'b', 'i', 'n', 'a', 'r', 'y', 0xc4, 0x02, 0x01, 0x02}
+
+	var out sample
+	if err := (Codec{}).Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out.Binary, []byte{0x01, 0x02}) {
+		t.Fatalf("Binary = % x, want 01 02", out.Binary)
+	}
+}
+
+func TestUnmarshalRejectsMalformedData(t *testing.T) {
+	data, _ := Codec{}.Marshal(map[string]string{"key": "value"})
+
+	var out map[string]string
+	if err := (Codec{}).Unmarshal(data[:len(data)-1], &out); err == nil {
+		t.Error("truncated data decoded without error")
+	}
+	if err := (Codec{}).Unmarshal(append(data, 0xc0), &out); err == nil {
+		t.Error("trailing data decoded without error")
+	}
+}
+
+func TestUnmarshalPrefix(t *testing.T) {
+	data, err := Codec{}.Marshal(map[string]interface{}{
+		"correlation_id": "corr-1",
+		"message_id":     "msg-1",
+		"result":         strings.Repeat("r", 1000),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ids struct {
+		CorrelationID string `json:"correlation_id"`
+		MessageID     string `json:"message_id"`
+		Result        string `json:"result"`
+	}
+	if err := (Codec{}).UnmarshalPrefix(data[:100], &ids); err != nil {
+		t.Fatal(err)
+	}
+	if ids.CorrelationID != "corr-1" || ids.MessageID != "msg-1" || ids.Result != "" {
+		t.Fatalf("decoded %+v, want both IDs and no result", ids)
+	}
+}
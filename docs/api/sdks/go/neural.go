@@ -0,0 +1,71 @@
+package a2aclient
+
+import "context"
+
+// NeuralTrainConfig represents a neural training run
+type NeuralTrainConfig struct {
+	ModelID      string
+	PatternType  string // e.g. "coordination", "optimization", "prediction"
+	Epochs       int
+	TrainingData string // reference to the training data set, such as a memory key or URL
+}
+
+// NeuralTrain trains a model on neural-trainer agents, which must reach
+// consensus on the result
+func (c *A2AClient) NeuralTrain(ctx context.Context, config NeuralTrainConfig) (*A2AResponse, error) {
+	message, err := neuralTrainMessage(config)
+	if err != nil {
+		return nil, err
+	}
+	return c.SendMessage(ctx, message)
+}
+
+// NeuralTrainStream starts a training run like NeuralTrain and forwards the
+// per-epoch progress responses as they arrive, ending with the final result.
+// The channels behave as for SendMessageStream.
+func (c *A2AClient) NeuralTrainStream(ctx context.Context, config NeuralTrainConfig) (<-chan *A2AResponse, <-chan error, error) {
+	message, err := neuralTrainMessage(config)
+	if err != nil {
+		return nil, nil, err
+	}
+	message.Parameters["stream"] = true
+	return c.SendMessageStream(ctx, message)
+}
+
+// neuralTrainMessage validates the config and builds the neural_train message
+func neuralTrainMessage(config NeuralTrainConfig) (*A2AMessage, error) {
+	if config.PatternType == "" {
+		return nil, NewA2AClientError(CodeValidation, "Neural training requires a pattern type", nil)
+	}
+	if config.Epochs <= 0 {
+		return nil, NewA2AClientError(CodeValidation, "Neural training epochs must be positive", config.Epochs)
+	}
+
+	message := &A2AMessage{
+		Target: AgentTarget{
+			GroupTarget: &GroupTarget{
+				Type: "group",
+				Role: AgentRoleNeuralTrainer,
+			},
+		},
+		ToolName: MCPToolClaudeFlowNeuralTrain,
+		Parameters: map[string]interface{}{
+			"patternType": config.PatternType,
+			"epochs":      config.Epochs,
+		},
+		Coordination: CoordinationMode{
+			ConsensusCoordination: &ConsensusCoordination{
+				Mode:                "consensus",
+				ConsensusType:       "majority",
+				MinimumParticipants: intPtr(2),
+			},
+		},
+	}
+	if config.ModelID != "" {
+		message.Parameters["modelId"] = config.ModelID
+	}
+	if config.TrainingData != "" {
+		message.Parameters["trainingData"] = config.TrainingData
+	}
+	return message, nil
+}
@@ -0,0 +1,76 @@
+package a2aclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestNeuralTrainMessage(t *testing.T) {
+	var sent *A2AMessage
+	client := memoryClient(func(_ context.Context, message *A2AMessage) (*A2AResponse, error) {
+		sent = message
+		return echoResult(message), nil
+	}, nil)
+
+	if _, err := client.NeuralTrain(context.Background(), NeuralTrainConfig{PatternType: "coordination"}); !HasCode(err, CodeValidation) {
+		t.Errorf("zero epochs: got %v, want VALIDATION_ERROR", err)
+	}
+	if _, err := client.NeuralTrain(context.Background(), NeuralTrainConfig{Epochs: 5}); !HasCode(err, CodeValidation) {
+		t.Errorf("no pattern type: got %v, want VALIDATION_ERROR", err)
+	}
+	if sent != nil {
+		t.Fatal("an invalid config was sent")
+	}
+
+	_, err := client.NeuralTrain(context.Background(), NeuralTrainConfig{ModelID: "m-1", PatternType: "prediction", Epochs: 5})
+	if err != nil {
+		t.Fatalf("NeuralTrain: %v", err)
+	}
+	if sent.ToolName != MCPToolClaudeFlowNeuralTrain || sent.Target.GroupTarget == nil || sent.Target.GroupTarget.Role != AgentRoleNeuralTrainer {
+		t.Errorf("sent %s to %+v, want neural_train to the neural-trainer group", sent.ToolName, sent.Target)
+	}
+	if sent.Parameters["modelId"] != "m-1" || sent.Parameters["epochs"] != 5.0 || sent.Parameters["trainingData"] != nil {
+		t.Errorf("params %v", sent.Parameters)
+	}
+	if sent.Coordination.ConsensusCoordination == nil {
+		t.Errorf("coordination %+v, want consensus", sent.Coordination)
+	}
+}
+
+func TestNeuralTrainStreamForwardsProgress(t *testing.T) {
+	server := newWSServer(t, func(conn *websocket.Conn, message *A2AMessage) *A2AResponse {
+		if message.Parameters["stream"] != true {
+			t.Errorf("params %v, want stream set", message.Parameters)
+		}
+		for epoch := 1; epoch <= 2; epoch++ {
+			progress := echoResult(message)
+			progress.Result = map[string]interface{}{"epoch": epoch}
+			conn.WriteJSON(progress)
+		}
+		final := echoResult(message)
+		final.Result = map[string]interface{}{"accuracy": 0.9}
+		final.Final = true
+		return final
+	})
+	client := connectWS(t, server, nil)
+
+	responses, errs, err := client.NeuralTrainStream(context.Background(), NeuralTrainConfig{PatternType: "optimization", Epochs: 2})
+	if err != nil {
+		t.Fatalf("NeuralTrainStream: %v", err)
+	}
+	var received []*A2AResponse
+	for response := range responses {
+		received = append(received, response)
+	}
+	if err := <-errs; err != nil {
+		t.Fatalf("stream: %v", err)
+	}
+	if len(received) != 3 || !received[2].Final {
+		t.Fatalf("received %d responses, want two progress updates and a final result", len(received))
+	}
+	if epoch := received[1].Result.(map[string]interface{})["epoch"]; epoch != 2.0 {
+		t.Errorf("second update for epoch %v, want 2", epoch)
+	}
+}
@@ -0,0 +1,233 @@
+package a2aclient
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ErrQueued is returned by SendMessage when QueueWhenOffline is enabled and
+// the client is not connected. The message is sent once the client connects
+// and its outcome reported to OnQueuedResult.
+var ErrQueued = NewA2AClientError(CodeQueued, "Message queued until the client is connected", nil)
+
+// OfflineMessage is a message held in an OfflineQueue
+type OfflineMessage struct {
+	Message    *A2AMessage `json:"message"`
+	EnqueuedAt time.Time   `json:"enqueued_at"`
+}
+
+// OfflineQueue stores messages sent while the client is offline. Drain
+// returns the stored messages in the order they were enqueued and empties
+// the queue.
+type OfflineQueue interface {
+	Enqueue(message OfflineMessage) error
+	Drain() ([]OfflineMessage, error)
+}
+
+// MemoryOfflineQueue is an OfflineQueue held in memory
+type MemoryOfflineQueue struct {
+	mu       sync.Mutex
+	messages []OfflineMessage
+}
+
+// NewMemoryOfflineQueue creates an empty in-memory offline queue
+func NewMemoryOfflineQueue() *MemoryOfflineQueue {
+	return &MemoryOfflineQueue{}
+}
+
+// Enqueue appends a message to the queue
+func (q *MemoryOfflineQueue) Enqueue(message OfflineMessage) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.messages = append(q.messages, message)
+	return nil
+}
+
+// Drain empties the queue, returning its messages oldest first
+func (q *MemoryOfflineQueue) Drain() ([]OfflineMessage, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	messages := q.messages
+	q.messages = nil
+	return messages, nil
+}
+
+// FileOfflineQueue is an OfflineQueue stored in a file, one JSON message per
+// line, so queued messages survive a restart of the process
+type FileOfflineQueue struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileOfflineQueue creates an offline queue stored at path. Messages
+// already in the file are kept and sent on the next drain.
+func NewFileOfflineQueue(path string) *FileOfflineQueue {
+	return &FileOfflineQueue{path: path}
+}
+
+// Enqueue appends a message to the file and syncs it to disk
+func (q *FileOfflineQueue) Enqueue(message OfflineMessage) error {
+	line, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("failed to marshal queued message: %w", err)
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	file, err := os.OpenFile(q.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open offline queue: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write offline queue: %w", err)
+	}
+	if err := file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync offline queue: %w", err)
+	}
+	return nil
+}
+
+// Drain reads every message from the file, oldest first, and truncates it. A
+// line that cannot be decoded, such as one cut short by a crash while it was
+// written, is skipped.
+func (q *FileOfflineQueue) Drain() ([]OfflineMessage, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	data, err := os.ReadFile(q.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read offline queue: %w", err)
+	}
+
+	var messages []OfflineMessage
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(data)+1)
+	for scanner.Scan() {
+		var message OfflineMessage
+		if err := json.Unmarshal(scanner.Bytes(), &message); err != nil || message.Message == nil {
+			continue
+		}
+		messages = append(messages, message)
+	}
+
+	if err := os.Truncate(q.path, 0); err != nil {
+		return nil, fmt.Errorf("failed to truncate offline queue: %w", err)
+	}
+	return messages, nil
+}
+
+// enqueueIfOffline stores the message in the offline queue when
+// QueueWhenOffline is enabled and the client is not connected, unless the
+// WebSocket is reconnecting and FallbackToHTTP sends it over HTTP instead.
+// The check and the enqueue happen under the connection lock so a
+// concurrent Connect cannot drain in between.
+func (c *A2AClient) enqueueIfOffline(message *A2AMessage) (bool, error) {
+	if !c.config.QueueWhenOffline {
+		return false, nil
+	}
+
+	c.connectionMux.RLock()
+	defer c.connectionMux.RUnlock()
+
+	if c.connected || (c.connectionLost && c.config.FallbackToHTTP) {
+		return false, nil
+	}
+	if err := c.config.OfflineQueue.Enqueue(OfflineMessage{Message: message, EnqueuedAt: time.Now()}); err != nil {
+		return true, err
+	}
+	return true, nil
+}
+
+// flushOfflineQueue sends every message stored while offline in priority
+// order, oldest first within a priority, and reports each outcome to
+// OnQueuedResult. Each message goes through SendMessage, and so through the
+// interceptors, metrics and tracing, with its own Timeout; it is not queued
+// again if the connection drops meanwhile. Messages whose TTL ran out are
+// dropped and reported with MESSAGE_EXPIRED.
+func (c *A2AClient) flushOfflineQueue() {
+	c.offlineFlushMutex.Lock()
+	defer c.offlineFlushMutex.Unlock()
+
+	messages, err := c.config.OfflineQueue.Drain()
+	if err != nil {
+		c.log("ERROR", "failed to drain offline queue", "error", err)
+		return
+	}
+	sort.SliceStable(messages, func(i, j int) bool {
+		return priorityRank(messages[i].Message.Priority) > priorityRank(messages[j].Message.Priority)
+	})
+
+	for _, queued := range messages {
+		message := queued.Message
+		if message.createdAt.IsZero() {
+			// Restored from storage, which does not keep the creation time
+			message.createdAt = queued.EnqueuedAt
+		}
+		if err := checkExpired(message); err != nil {
+			c.deadLetter(message, err)
+			c.reportQueuedResult(message, nil, err)
+			continue
+		}
+		outgoing := message
+		if deadline := message.expiresAt(); !deadline.IsZero() {
+			outgoing = refreshTTL(message, deadline)
+		}
+
+		ctx, cancel := context.WithTimeout(withoutQueueing(context.Background()), c.config.Timeout)
+		response, err := c.SendMessage(ctx, outgoing)
+		cancel()
+		c.reportQueuedResult(message, response, err)
+	}
+}
+
+type noQueueKey struct{}
+
+// withoutQueueing returns a context whose messages are sent right away
+// rather than buffered for reconnection or stored while offline, for
+// messages being flushed from those queues
+func withoutQueueing(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noQueueKey{}, true)
+}
+
+// queueingDisabled reports whether ctx came from withoutQueueing
+func queueingDisabled(ctx context.Context) bool {
+	disabled, _ := ctx.Value(noQueueKey{}).(bool)
+	return disabled
+}
+
+// dropOfflineQueue empties the offline queue, reporting each message to
+// OnQueuedResult with err
+func (c *A2AClient) dropOfflineQueue(err error) {
+	c.offlineFlushMutex.Lock()
+	defer c.offlineFlushMutex.Unlock()
+
+	messages, drainErr := c.config.OfflineQueue.Drain()
+	if drainErr != nil {
+		c.log("ERROR", "failed to drain offline queue", "error", drainErr)
+		return
+	}
+	for _, queued := range messages {
+		c.reportQueuedResult(queued.Message, nil, err)
+	}
+}
+
+// reportQueuedResult passes the outcome of a queued message to the
+// OnQueuedResult callback, if set
+func (c *A2AClient) reportQueuedResult(message *A2AMessage, response *A2AResponse, err error) {
+	if c.config.OnQueuedResult != nil {
+		c.config.OnQueuedResult(message, response, err)
+	}
+}
@@ -0,0 +1,65 @@
+package a2aclient
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFileOfflineQueueSurvivesRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "offline.jsonl")
+
+	first := memoryClient(func(context.Context, *A2AMessage) (*A2AResponse, error) {
+		t.Error("first client sent the message")
+		return nil, nil
+	}, func(config *A2AClientConfig) {
+		config.QueueWhenOffline = true
+		config.OfflineQueue = NewFileOfflineQueue(path)
+	})
+	message := directMessage(MCPToolClaudeFlowAgentSpawn, map[string]interface{}{"name": "queued"})
+	if _, err := first.SendMessage(context.Background(), message); !errors.Is(err, ErrQueued) {
+		t.Fatalf("got %v, want ErrQueued", err)
+	}
+	first.Shutdown(context.Background())
+
+	// The restarted client sends the stored message through its
+	// interceptors once it connects
+	var intercepted []string
+	results := make(chan error, 1)
+	second := memoryClient(func(_ context.Context, message *A2AMessage) (*A2AResponse, error) {
+		return echoResult(message), nil
+	}, func(config *A2AClientConfig) {
+		config.QueueWhenOffline = true
+		config.OfflineQueue = NewFileOfflineQueue(path)
+		config.Interceptors = []Interceptor{
+			func(ctx context.Context, message *A2AMessage, next SendFunc) (*A2AResponse, error) {
+				intercepted = append(intercepted, message.ID)
+				return next(ctx, message)
+			},
+		}
+		config.OnQueuedResult = func(queued *A2AMessage, response *A2AResponse, err error) {
+			if queued.ID != message.ID || response == nil || response.MessageID != message.ID {
+				t.Errorf("got result %+v for %s, want the response to %s", response, queued.ID, message.ID)
+			}
+			results <- err
+		}
+	})
+	if err := second.Connect(context.Background()); err != nil {
+		t.Fatalf("Connect: %v", err)
+	}
+	defer second.Disconnect()
+
+	select {
+	case err := <-results:
+		if err != nil {
+			t.Fatalf("queued message failed: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("queued message was not sent after restart")
+	}
+	if len(intercepted) != 1 || intercepted[0] != message.ID {
+		t.Errorf("interceptor saw %v, want [%s]", intercepted, message.ID)
+	}
+}
@@ -0,0 +1,181 @@
+package a2aclient
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// pendingSweepInterval is how often the janitor looks for pending requests
+// whose deadline has passed
+const pendingSweepInterval = time.Second
+
+// pendingGrace is added to a request's own timeout before the janitor
+// reclaims its entry, so that it only ever removes entries the request
+// failed to remove itself
+const pendingGrace = 10 * time.Second
+
+// pendingRequest routes responses for one request. closed is set, under
+// mu, when the janitor closes ch, so routeResponse never sends on a closed
+// channel.
+type pendingRequest struct {
+	mu            sync.Mutex
+	ch            chan *A2AResponse
+	messageID     string
+	correlationID string    // empty unless the request holds the correlation ID
+	deadline      time.Time // zero for streams and subscriptions, which never expire
+	closed        bool
+}
+
+// deliver passes response to the waiting request without blocking; it is
+// dropped if the channel is full or closed
+func (p *pendingRequest) deliver(response *A2AResponse) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return
+	}
+	select {
+	case p.ch <- response:
+	default:
+	}
+}
+
+// close closes the channel, waking the request with no response
+func (p *pendingRequest) close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.closed {
+		p.closed = true
+		close(p.ch)
+	}
+}
+
+// registerPending routes responses echoing the message ID to ch, and those
+// carrying only the correlation ID too unless another pending request
+// already holds it: correlation IDs group messages and may be shared.
+// Unless deadline is zero, the janitor closes ch and drops the entry once
+// deadline has passed, in case the request never unregisters. The returned
+// function removes the entry; it fails with VALIDATION_ERROR if a request
+// with the same message ID is already pending.
+func (c *A2AClient) registerPending(messageID, correlationID string, ch chan *A2AResponse, deadline time.Time) (func(), error) {
+	entry := &pendingRequest{ch: ch, messageID: messageID, deadline: deadline}
+	if _, loaded := c.pending.LoadOrStore(messageID, entry); loaded {
+		return nil, NewA2AClientError(CodeValidation, fmt.Sprintf("A request with message ID %q is already pending", messageID), messageID)
+	}
+	c.observeInFlight(int(atomic.AddInt64(&c.pendingCount, 1)))
+	if correlationID != "" && correlationID != messageID {
+		if _, loaded := c.pendingByCorrelation.LoadOrStore(correlationID, entry); !loaded {
+			entry.correlationID = correlationID
+		}
+	}
+	if !deadline.IsZero() {
+		c.startPendingJanitor()
+	}
+	return func() { c.unregisterPending(entry) }, nil
+}
+
+// unregisterPending removes entry, leaving any entry registered later under
+// the same IDs alone
+func (c *A2AClient) unregisterPending(entry *pendingRequest) {
+	if entry.correlationID != "" {
+		c.pendingByCorrelation.CompareAndDelete(entry.correlationID, entry)
+	}
+	if c.pending.CompareAndDelete(entry.messageID, entry) {
+		c.observeInFlight(int(atomic.AddInt64(&c.pendingCount, -1)))
+	}
+}
+
+// lookupPending returns the request a response is for: the one with the
+// message ID it echoes or, failing that, the one holding its correlation ID
+func (c *A2AClient) lookupPending(response *A2AResponse) (*pendingRequest, bool) {
+	if value, ok := c.pending.Load(response.MessageID); ok {
+		return value.(*pendingRequest), true
+	}
+	if value, ok := c.pending.Load(response.CorrelationID); ok {
+		return value.(*pendingRequest), true
+	}
+	if value, ok := c.pendingByCorrelation.Load(response.CorrelationID); ok {
+		return value.(*pendingRequest), true
+	}
+	return nil, false
+}
+
+// pendingRequests returns the number of requests waiting for a response
+func (c *A2AClient) pendingRequests() int {
+	return int(atomic.LoadInt64(&c.pendingCount))
+}
+
+// failPending answers every request waiting for a single response with
+// err, leaving streams and subscriptions alone. Each request removes its
+// own entry as it returns.
+func (c *A2AClient) failPending(err *A2AClientError) {
+	c.pending.Range(func(_, value interface{}) bool {
+		entry := value.(*pendingRequest)
+		if entry.deadline.IsZero() {
+			return true
+		}
+		entry.deliver(&A2AResponse{
+			MessageID: entry.messageID,
+			Success:   false,
+			Error:     &A2AError{Code: err.Code, Message: err.Message},
+			Final:     true,
+		})
+		return true
+	})
+}
+
+// startPendingJanitor starts the janitor unless it is already running. It
+// runs only while requests with a deadline are pending, so an idle client
+// has no goroutine to stop.
+func (c *A2AClient) startPendingJanitor() {
+	if atomic.CompareAndSwapInt32(&c.janitorRunning, 0, 1) {
+		go c.sweepPending()
+	}
+}
+
+// sweepPending removes and closes expired entries every
+// pendingSweepInterval until no entry with a deadline is left
+func (c *A2AClient) sweepPending() {
+	ticker := time.NewTicker(pendingSweepInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if c.reclaimExpired(time.Now()) > 0 {
+			continue
+		}
+		atomic.StoreInt32(&c.janitorRunning, 0)
+		// A request registered between the sweep and the store saw the
+		// janitor running and did not start another; take over if so
+		if c.reclaimExpired(time.Now()) == 0 || !atomic.CompareAndSwapInt32(&c.janitorRunning, 0, 1) {
+			return
+		}
+	}
+}
+
+// reclaimExpired removes and closes the entries whose deadline is before
+// now, returning how many entries with a deadline remain
+func (c *A2AClient) reclaimExpired(now time.Time) int {
+	remaining := 0
+	c.pending.Range(func(key, value interface{}) bool {
+		entry := value.(*pendingRequest)
+		if entry.deadline.IsZero() {
+			return true
+		}
+		if now.Before(entry.deadline) {
+			remaining++
+			return true
+		}
+		if c.pending.CompareAndDelete(key, value) {
+			c.observeInFlight(int(atomic.AddInt64(&c.pendingCount, -1)))
+			if entry.correlationID != "" {
+				c.pendingByCorrelation.CompareAndDelete(entry.correlationID, entry)
+			}
+			entry.close()
+			c.log("WARN", "reclaimed expired pending request", "message_id", key)
+		}
+		return true
+	})
+	return remaining
+}
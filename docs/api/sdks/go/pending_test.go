@@ -0,0 +1,106 @@
+package a2aclient
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// syncMapLen counts the entries of a sync.Map
+func syncMapLen(m *sync.Map) int {
+	n := 0
+	m.Range(func(_, _ interface{}) bool {
+		n++
+		return true
+	})
+	return n
+}
+
+func TestPendingQueueEmptyAfterFailures(t *testing.T) {
+	server := newWSServer(t, func(_ *websocket.Conn, message *A2AMessage) *A2AResponse {
+		if message.Parameters["fail"] != "error" {
+			// Never answered; the request times out or is cancelled
+			return nil
+		}
+		response := echoResult(message)
+		response.Success = false
+		response.Error = &A2AError{Code: "TOOL_FAILED", Message: "failed"}
+		return response
+	})
+	client := connectWS(t, server, func(config *A2AClientConfig) {
+		config.RetryPolicy = fastRetries(0)
+		config.Timeout = 100 * time.Millisecond
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 60; i++ {
+		fail := []string{"error", "silent", "cancel"}[i%3]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if fail == "cancel" {
+				time.AfterFunc(10*time.Millisecond, cancel)
+			}
+			message := directMessage(MCPToolClaudeFlowSwarmStatus, map[string]interface{}{"fail": fail})
+			// Correlated requests also register under the correlation ID
+			message.CorrelationID = "group-" + fail
+			if response, err := client.SendMessage(ctx, message); err == nil && response.Success {
+				t.Errorf("%s request succeeded", fail)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := client.pendingRequests(); n != 0 {
+		t.Errorf("%d requests still counted as pending", n)
+	}
+	if n := syncMapLen(&client.pending); n != 0 {
+		t.Errorf("%d entries left by message ID", n)
+	}
+	if n := syncMapLen(&client.pendingByCorrelation); n != 0 {
+		t.Errorf("%d entries left by correlation ID", n)
+	}
+}
+
+func TestReclaimExpiredClosesLeakedEntries(t *testing.T) {
+	client := memoryClient(nil, nil)
+	now := time.Now()
+
+	expired := make(chan *A2AResponse, 1)
+	if _, err := client.registerPending("expired", "corr", expired, now.Add(-time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	live := make(chan *A2AResponse, 1)
+	unregister, err := client.registerPending("live", "", live, now.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unregister()
+	stream := make(chan *A2AResponse, 1)
+	unregisterStream, err := client.registerPending("stream", "", stream, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unregisterStream()
+
+	if remaining := client.reclaimExpired(now); remaining != 1 {
+		t.Errorf("reclaimExpired left %d entries with a deadline, want 1", remaining)
+	}
+	if _, ok := <-expired; ok {
+		t.Error("expired channel not closed")
+	}
+	if n := client.pendingRequests(); n != 2 {
+		t.Errorf("%d requests pending, want the live request and the stream", n)
+	}
+	if _, ok := client.pendingByCorrelation.Load("corr"); ok {
+		t.Error("expired entry still holds its correlation ID")
+	}
+	// A late response for the reclaimed request is dropped, not sent on the
+	// closed channel
+	client.routeResponse(&A2AResponse{MessageID: "expired"})
+}
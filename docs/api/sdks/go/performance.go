@@ -0,0 +1,136 @@
+package a2aclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// PerformanceWindows lists the reporting periods accepted by
+// PerformanceReport
+var PerformanceWindows = []string{"1h", "6h", "24h", "7d", "30d"}
+
+// PerformanceReport is the decoded result of a performance_report query
+type PerformanceReport struct {
+	Window     string
+	Throughput float64 // requests per second
+	P50        time.Duration
+	P95        time.Duration
+	P99        time.Duration
+	Tokens     TokenUsage
+	ErrorRate  float64 // fraction of failed requests, 0-1
+	Agents     map[string]AgentPerformance
+}
+
+// TokenUsage counts the tokens consumed during a reporting period
+type TokenUsage struct {
+	Input  int64 `json:"input"`
+	Output int64 `json:"output"`
+	Total  int64 `json:"total"`
+}
+
+// AgentPerformance is one agent's share of a performance report
+type AgentPerformance struct {
+	Requests  int64
+	ErrorRate float64
+	P50       time.Duration
+	P95       time.Duration
+	P99       time.Duration
+	Tokens    TokenUsage
+}
+
+// latencyWire mirrors the wire format of latency percentiles, in milliseconds
+type latencyWire struct {
+	P50 *float64 `json:"p50"`
+	P95 *float64 `json:"p95"`
+	P99 *float64 `json:"p99"`
+}
+
+// performanceReportWire mirrors the wire format of a performance report
+type performanceReportWire struct {
+	Window     string      `json:"window"`
+	Throughput float64     `json:"throughput"`
+	Latency    latencyWire `json:"latency"`
+	Tokens     TokenUsage  `json:"tokens"`
+	ErrorRate  float64     `json:"error_rate"`
+	Agents     map[string]struct {
+		Requests  int64       `json:"requests"`
+		ErrorRate float64     `json:"error_rate"`
+		Latency   latencyWire `json:"latency"`
+		Tokens    TokenUsage  `json:"tokens"`
+	} `json:"agents"`
+}
+
+// PerformanceReport fetches the performance report for the given window, one
+// of PerformanceWindows
+func (c *A2AClient) PerformanceReport(ctx context.Context, window string) (*PerformanceReport, error) {
+	valid := false
+	for _, accepted := range PerformanceWindows {
+		if window == accepted {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return nil, NewA2AClientError(CodeValidation, fmt.Sprintf("Unknown performance report window %q", window), PerformanceWindows)
+	}
+
+	message := &A2AMessage{
+		Target: AgentTarget{
+			GroupTarget: &GroupTarget{
+				Type:              "group",
+				Role:              AgentRolePerformanceMonitor,
+				MaxAgents:         intPtr(1),
+				SelectionStrategy: "load-balanced",
+			},
+		},
+		ToolName: MCPToolClaudeFlowPerformanceReport,
+		Parameters: map[string]interface{}{
+			"timeframe": window,
+			"format":    "json",
+		},
+		Coordination: CoordinationMode{
+			DirectCoordination: &DirectCoordination{
+				Mode: "direct",
+			},
+		},
+	}
+
+	response, err := c.SendMessage(ctx, message)
+	if err != nil {
+		return nil, err
+	}
+	if err := responseError(response); err != nil {
+		return nil, err
+	}
+
+	var wire performanceReportWire
+	if err := decodeMap(response.Result, &wire); err != nil {
+		return nil, NewA2AClientError(CodeDecode, fmt.Sprintf("Failed to decode performance report: %v", err), response.Result)
+	}
+
+	report := &PerformanceReport{
+		Window:     wire.Window,
+		Throughput: wire.Throughput,
+		P50:        millisToDuration(wire.Latency.P50),
+		P95:        millisToDuration(wire.Latency.P95),
+		P99:        millisToDuration(wire.Latency.P99),
+		Tokens:     wire.Tokens,
+		ErrorRate:  wire.ErrorRate,
+		Agents:     make(map[string]AgentPerformance, len(wire.Agents)),
+	}
+	if report.Window == "" {
+		report.Window = window
+	}
+	for agentID, agent := range wire.Agents {
+		report.Agents[agentID] = AgentPerformance{
+			Requests:  agent.Requests,
+			ErrorRate: agent.ErrorRate,
+			P50:       millisToDuration(agent.Latency.P50),
+			P95:       millisToDuration(agent.Latency.P95),
+			P99:       millisToDuration(agent.Latency.P99),
+			Tokens:    agent.Tokens,
+		}
+	}
+	return report, nil
+}
@@ -0,0 +1,45 @@
+package a2aclient
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestPerformanceReportDecodesLatencies(t *testing.T) {
+	var sent *A2AMessage
+	client := memoryClient(func(_ context.Context, message *A2AMessage) (*A2AResponse, error) {
+		sent = message
+		return &A2AResponse{Success: true, Result: map[string]interface{}{
+			"throughput": 12.5,
+			"latency":    map[string]interface{}{"p50": 1.5, "p95": 20, "p99": 250},
+			"tokens":     map[string]interface{}{"input": 100, "output": 50, "total": 150},
+			"error_rate": 0.02,
+			"agents": map[string]interface{}{
+				"agent-1": map[string]interface{}{"requests": 7, "latency": map[string]interface{}{"p95": 30}},
+			},
+		}}, nil
+	}, nil)
+
+	if _, err := client.PerformanceReport(context.Background(), "2h"); !HasCode(err, CodeValidation) {
+		t.Errorf("unknown window: got %v, want VALIDATION_ERROR", err)
+	}
+
+	report, err := client.PerformanceReport(context.Background(), "24h")
+	if err != nil {
+		t.Fatalf("PerformanceReport: %v", err)
+	}
+	if sent.Parameters["timeframe"] != "24h" {
+		t.Errorf("params %v, want timeframe 24h", sent.Parameters)
+	}
+	if report.Window != "24h" || report.Throughput != 12.5 || report.ErrorRate != 0.02 || report.Tokens.Total != 150 {
+		t.Errorf("decoded %+v", report)
+	}
+	if report.P50 != 1500*time.Microsecond || report.P95 != 20*time.Millisecond || report.P99 != 250*time.Millisecond {
+		t.Errorf("latencies %v, %v, %v, want 1.5ms, 20ms, 250ms", report.P50, report.P95, report.P99)
+	}
+	agent := report.Agents["agent-1"]
+	if agent.Requests != 7 || agent.P95 != 30*time.Millisecond || agent.P50 != 0 {
+		t.Errorf("agent-1 %+v, want 7 requests, p95 30ms and no p50", agent)
+	}
+}
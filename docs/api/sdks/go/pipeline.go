@@ -0,0 +1,271 @@
+package a2aclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TransformFunc reshapes a stage's input or output according to the
+// stage's InputTransform or OutputTransform expression
+type TransformFunc func(expr string, value interface{}) (interface{}, error)
+
+// PipelineOptions configures RunPipeline
+type PipelineOptions struct {
+	// Target receives stages that have no AgentTarget of their own
+	Target *AgentTarget
+	// Transform applies stage transforms (default JSONPathTransform)
+	Transform TransformFunc
+}
+
+// RunPipeline runs a pipeline stage by stage from the client, sending each
+// stage as its own message, and returns the resulting trace. Each stage's
+// result is reshaped by its OutputTransform. With StatePassthrough, the
+// previous stage's output is reshaped by the next stage's InputTransform and
+// merged into its parameters: an object is merged key by key, anything else
+// is passed as the "input" parameter. A failing transform fails its stage
+// with TRANSFORM_ERROR. Failed stages are handled by the FailureStrategy:
+// "abort" (default) stops the pipeline and returns the error with the trace,
+// "skip" moves on to the next stage with the last good output, and "retry"
+// runs the stage once more before aborting. If any stage declares
+// DependsOn, the pipeline is run by RunPipelineDAG instead.
+func (c *A2AClient) RunPipeline(ctx context.Context, pipeline PipelineCoordination, options PipelineOptions) (*PipelineTrace, error) {
+	for _, stage := range pipeline.Stages {
+		if len(stage.DependsOn) > 0 {
+			return c.RunPipelineDAG(ctx, pipeline, options)
+		}
+	}
+
+	transform := options.Transform
+	if transform == nil {
+		transform = JSONPathTransform
+	}
+	trace := newPipelineTrace(pipeline)
+
+	var previous interface{}
+	for i, stage := range pipeline.Stages {
+		stageTrace := &trace.Stages[i]
+		err := c.attemptStage(ctx, stage, options.Target, transform, pipeline.StatePassthrough, trace.FailureStrategy, previous, stageTrace)
+		if err == nil {
+			previous = stageTrace.Output
+			continue
+		}
+
+		if trace.FailureStrategy == "skip" && ctx.Err() == nil {
+			stageTrace.Status = StageStatusSkipped
+			continue
+		}
+		for j := i + 1; j < len(trace.Stages); j++ {
+			trace.Stages[j].Status = StageStatusAborted
+		}
+		return trace, fmt.Errorf("pipeline stage %q failed: %w", stage.Name, err)
+	}
+
+	return trace, nil
+}
+
+// newPipelineTrace returns a trace with every stage of pipeline pending and
+// the failure strategy defaulted to "abort"
+func newPipelineTrace(pipeline PipelineCoordination) *PipelineTrace {
+	failureStrategy := pipeline.FailureStrategy
+	if failureStrategy == "" {
+		failureStrategy = "abort"
+	}
+
+	trace := &PipelineTrace{FailureStrategy: failureStrategy}
+	for _, stage := range pipeline.Stages {
+		trace.Stages = append(trace.Stages, StageTrace{
+			Name:            stage.Name,
+			Status:          StageStatusPending,
+			InputTransform:  stage.InputTransform,
+			OutputTransform: stage.OutputTransform,
+		})
+	}
+	return trace
+}
+
+// attemptStage runs a stage, once more under the "retry" strategy if it
+// fails, and marks it completed or retried on success
+func (c *A2AClient) attemptStage(ctx context.Context, stage PipelineStage, defaultTarget *AgentTarget, transform TransformFunc, passthrough bool, failureStrategy string, previous interface{}, stageTrace *StageTrace) error {
+	attempts := 1
+	if failureStrategy == "retry" {
+		attempts = 2
+	}
+	var err error
+	for stageTrace.Attempts < attempts {
+		stageTrace.Attempts++
+		err = c.runStage(ctx, stage, defaultTarget, transform, passthrough, previous, stageTrace)
+		if err == nil || ctx.Err() != nil {
+			break
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if stageTrace.Attempts > 1 {
+		stageTrace.Status = StageStatusRetried
+	} else {
+		stageTrace.Status = StageStatusCompleted
+	}
+	return nil
+}
+
+// runStage sends one pipeline stage and records its input, output and
+// outcome in stageTrace
+func (c *A2AClient) runStage(ctx context.Context, stage PipelineStage, defaultTarget *AgentTarget, transform TransformFunc, passthrough bool, previous interface{}, stageTrace *StageTrace) error {
+	start := time.Now()
+	stageTrace.Status = StageStatusRunning
+	defer func() {
+		stageTrace.DurationMs = float64(time.Since(start)) / float64(time.Millisecond)
+	}()
+
+	fail := func(err error) error {
+		stageTrace.Status = StageStatusFailed
+		stageTrace.Error = &A2AError{Code: CodeRequestFailed, Message: err.Error()}
+		var clientErr *A2AClientError
+		if errors.As(err, &clientErr) {
+			stageTrace.Error = &A2AError{Code: clientErr.Code, Message: clientErr.Message, Details: clientErr.Details}
+		}
+		return err
+	}
+
+	target := stage.AgentTarget
+	if target == nil {
+		target = defaultTarget
+	}
+	if target == nil {
+		return fail(NewA2AClientError(CodeValidation, fmt.Sprintf("Stage %q has no agent target", stage.Name), nil))
+	}
+
+	params := make(map[string]interface{})
+	if stage.Parameters != nil {
+		if err := decodeMap(stage.Parameters, &params); err != nil {
+			return fail(NewA2AClientError(CodeValidation, fmt.Sprintf("Stage %q parameters must be an object: %v", stage.Name, err), stage.Parameters))
+		}
+	}
+
+	if passthrough && previous != nil {
+		input := previous
+		if stage.InputTransform != "" {
+			transformed, err := transform(stage.InputTransform, previous)
+			if err != nil {
+				return fail(NewA2AClientError(CodeTransform, fmt.Sprintf("Stage %q input transform: %v", stage.Name, err), stage.InputTransform))
+			}
+			input = transformed
+		}
+		if fields, ok := input.(map[string]interface{}); ok {
+			for key, value := range fields {
+				params[key] = value
+			}
+		} else {
+			params["input"] = input
+		}
+	}
+	stageTrace.Input = params
+
+	message := &A2AMessage{
+		Target:     *target,
+		ToolName:   MCPToolName(stage.ToolName),
+		Parameters: params,
+		Coordination: CoordinationMode{
+			DirectCoordination: &DirectCoordination{
+				Mode: "direct",
+			},
+		},
+	}
+	if stage.Timeout != nil {
+		message.Execution = &ExecutionContext{Timeout: stage.Timeout}
+	}
+
+	response, err := c.SendMessage(ctx, message)
+	if err == nil {
+		err = responseError(response)
+	}
+	if err != nil {
+		return fail(err)
+	}
+	if response.Source.AgentID != "" {
+		stageTrace.Agent = &response.Source
+	}
+
+	var output interface{}
+	if err := decodeMap(response.Result, &output); err != nil {
+		return fail(fmt.Errorf("failed to decode stage result: %w", err))
+	}
+	if stage.OutputTransform != "" {
+		transformed, err := transform(stage.OutputTransform, output)
+		if err != nil {
+			return fail(NewA2AClientError(CodeTransform, fmt.Sprintf("Stage %q output transform: %v", stage.Name, err), stage.OutputTransform))
+		}
+		output = transformed
+	}
+	stageTrace.Output = output
+	stageTrace.Error = nil
+	return nil
+}
+
+// JSONPathTransform evaluates a JSONPath expression against a decoded JSON
+// value. It supports the root "$", child names ("$.a.b" or "$['a']") and
+// array indices ("$.items[0]"); the leading "$" may be omitted. A path that
+// does not resolve is an error.
+func JSONPathTransform(expr string, value interface{}) (interface{}, error) {
+	segments, err := parseJSONPath(expr)
+	if err != nil {
+		return nil, err
+	}
+	result, ok := lookupSegments(value, segments)
+	if !ok {
+		return nil, fmt.Errorf("path %s does not match the value", expr)
+	}
+	return result, nil
+}
+
+// parseJSONPath splits a JSONPath expression into object keys and array
+// indices
+func parseJSONPath(expr string) ([]string, error) {
+	path := strings.TrimPrefix(strings.TrimSpace(expr), "$")
+	if path != "" && path[0] != '.' && path[0] != '[' {
+		// A bare name without "$."
+		path = "." + path
+	}
+
+	var segments []string
+	for len(path) > 0 {
+		switch path[0] {
+		case '.':
+			path = path[1:]
+			end := strings.IndexAny(path, ".[")
+			if end < 0 {
+				end = len(path)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("invalid JSONPath %q: empty name", expr)
+			}
+			segments = append(segments, path[:end])
+			path = path[end:]
+		case '[':
+			end := strings.IndexByte(path, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid JSONPath %q: unclosed bracket", expr)
+			}
+			inner := path[1:end]
+			path = path[end+1:]
+			if unquoted, err := strconv.Unquote(inner); err == nil {
+				segments = append(segments, unquoted)
+			} else if len(inner) >= 2 && inner[0] == '\'' && inner[len(inner)-1] == '\'' {
+				segments = append(segments, inner[1:len(inner)-1])
+			} else if _, err := strconv.Atoi(inner); err == nil {
+				segments = append(segments, inner)
+			} else {
+				return nil, fmt.Errorf("invalid JSONPath %q: unsupported selector [%s]", expr, inner)
+			}
+		default:
+			return nil, fmt.Errorf("invalid JSONPath %q: unexpected %q", expr, path[0])
+		}
+	}
+	return segments, nil
+}
@@ -0,0 +1,223 @@
+package a2aclient
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// stageGraph is the dependency graph of a pipeline's stages, by index
+type stageGraph struct {
+	dependencies [][]int // the stages each stage waits for
+	dependents   [][]int // the stages waiting for each stage
+}
+
+// buildStageGraph resolves DependsOn to stage indices. Stage names must be
+// unique and, once any stage uses DependsOn, present on every stage. Stages
+// that form a cycle fail with PIPELINE_CYCLE.
+func buildStageGraph(stages []PipelineStage) (*stageGraph, error) {
+	usesDependencies := false
+	for _, stage := range stages {
+		if len(stage.DependsOn) > 0 {
+			usesDependencies = true
+			break
+		}
+	}
+
+	byName := make(map[string]int, len(stages))
+	for i, stage := range stages {
+		if stage.Name == "" {
+			if usesDependencies {
+				return nil, NewA2AClientError(CodeValidation,
+					fmt.Sprintf("Pipeline stage %d has no name; every stage needs one when any uses DependsOn", i), i)
+			}
+			continue
+		}
+		if _, dup := byName[stage.Name]; dup {
+			return nil, NewA2AClientError(CodeValidation, fmt.Sprintf("Duplicate pipeline stage name %q", stage.Name), stage.Name)
+		}
+		byName[stage.Name] = i
+	}
+
+	graph := &stageGraph{
+		dependencies: make([][]int, len(stages)),
+		dependents:   make([][]int, len(stages)),
+	}
+	for i, stage := range stages {
+		for _, name := range stage.DependsOn {
+			j, ok := byName[name]
+			if !ok {
+				return nil, NewA2AClientError(CodeValidation, fmt.Sprintf("Stage %q depends on unknown stage %q", stage.Name, name), name)
+			}
+			graph.dependencies[i] = append(graph.dependencies[i], j)
+			graph.dependents[j] = append(graph.dependents[j], i)
+		}
+	}
+
+	// Kahn's algorithm: whatever cannot be ordered is on a cycle or
+	// depends on one
+	waiting := make([]int, len(stages))
+	var ready []int
+	for i := range stages {
+		waiting[i] = len(graph.dependencies[i])
+		if waiting[i] == 0 {
+			ready = append(ready, i)
+		}
+	}
+	ordered := 0
+	for len(ready) > 0 {
+		i := ready[0]
+		ready = ready[1:]
+		ordered++
+		for _, j := range graph.dependents[i] {
+			waiting[j]--
+			if waiting[j] == 0 {
+				ready = append(ready, j)
+			}
+		}
+	}
+	if ordered < len(stages) {
+		// Peel off the stages that merely depend on a cycle, which no
+		// unordered stage waits for, leaving the cycles themselves
+		onCycle := make([]bool, len(stages))
+		for i := range stages {
+			onCycle[i] = waiting[i] > 0
+		}
+		for peeled := true; peeled; {
+			peeled = false
+			for i := range stages {
+				if onCycle[i] && !anyOf(graph.dependents[i], onCycle) {
+					onCycle[i] = false
+					peeled = true
+				}
+			}
+		}
+
+		var cyclic []string
+		for i, stage := range stages {
+			if onCycle[i] {
+				cyclic = append(cyclic, stage.Name)
+			}
+		}
+		return nil, NewA2AClientError(CodePipelineCycle,
+			fmt.Sprintf("Pipeline stages form a cycle: %s", strings.Join(cyclic, ", ")), cyclic)
+	}
+	return graph, nil
+}
+
+// anyOf reports whether set holds any of indices
+func anyOf(indices []int, set []bool) bool {
+	for _, i := range indices {
+		if set[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// stageDone reports the end of a stage run by RunPipelineDAG
+type stageDone struct {
+	index int
+	err   error
+}
+
+// RunPipelineDAG runs a pipeline from the client as a dependency graph:
+// each stage starts as soon as every stage named in its DependsOn has
+// finished, so independent stages run concurrently. Stages and transforms
+// are handled as by RunPipeline, except that with StatePassthrough a stage
+// receives the output of the stages it depends on: the output itself for a
+// single dependency, or an object keyed by stage name for several. A
+// skipped stage passes on what it received. Under "abort", no further
+// stages start once one fails; those already running are waited for, and
+// the rest are marked aborted. Unnamed stages alongside DependsOn and
+// dependencies on unknown or duplicate stage names fail with
+// VALIDATION_ERROR and cycles with PIPELINE_CYCLE, in both cases before any
+// stage runs.
+func (c *A2AClient) RunPipelineDAG(ctx context.Context, pipeline PipelineCoordination, options PipelineOptions) (*PipelineTrace, error) {
+	graph, err := buildStageGraph(pipeline.Stages)
+	if err != nil {
+		return nil, err
+	}
+
+	transform := options.Transform
+	if transform == nil {
+		transform = JSONPathTransform
+	}
+	trace := newPipelineTrace(pipeline)
+
+	stages := pipeline.Stages
+	received := make([]interface{}, len(stages))
+	forwarded := make([]interface{}, len(stages))
+	waiting := make([]int, len(stages))
+	done := make(chan stageDone, len(stages))
+	running := 0
+
+	start := func(i int) {
+		switch deps := graph.dependencies[i]; len(deps) {
+		case 0:
+		case 1:
+			received[i] = forwarded[deps[0]]
+		default:
+			inputs := make(map[string]interface{}, len(deps))
+			for _, j := range deps {
+				if forwarded[j] != nil {
+					inputs[stages[j].Name] = forwarded[j]
+				}
+			}
+			received[i] = inputs
+		}
+
+		running++
+		go func(i int, previous interface{}) {
+			err := c.attemptStage(ctx, stages[i], options.Target, transform, pipeline.StatePassthrough, trace.FailureStrategy, previous, &trace.Stages[i])
+			done <- stageDone{index: i, err: err}
+		}(i, received[i])
+	}
+
+	for i := range stages {
+		waiting[i] = len(graph.dependencies[i])
+		if waiting[i] == 0 {
+			start(i)
+		}
+	}
+
+	var failure error
+	for running > 0 {
+		result := <-done
+		running--
+		i := result.index
+
+		switch {
+		case result.err == nil:
+			forwarded[i] = trace.Stages[i].Output
+		case trace.FailureStrategy == "skip" && ctx.Err() == nil:
+			trace.Stages[i].Status = StageStatusSkipped
+			forwarded[i] = received[i]
+		default:
+			if failure == nil {
+				failure = fmt.Errorf("pipeline stage %q failed: %w", stages[i].Name, result.err)
+			}
+			continue
+		}
+
+		if failure != nil {
+			continue
+		}
+		for _, j := range graph.dependents[i] {
+			waiting[j]--
+			if waiting[j] == 0 {
+				start(j)
+			}
+		}
+	}
+
+	if failure != nil {
+		for i := range trace.Stages {
+			if trace.Stages[i].Status == StageStatusPending {
+				trace.Stages[i].Status = StageStatusAborted
+			}
+		}
+		return trace, failure
+	}
+	return trace, nil
+}
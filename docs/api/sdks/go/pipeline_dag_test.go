@@ -0,0 +1,120 @@
+package a2aclient
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// dagStage returns a stage named name calling the tool of the same name
+func dagStage(name string, dependsOn ...string) PipelineStage {
+	return PipelineStage{Name: name, ToolName: name, DependsOn: dependsOn}
+}
+
+func TestRunPipelineDAGDiamond(t *testing.T) {
+	// b and c wait for each other, so they must run concurrently
+	var middle sync.WaitGroup
+	middle.Add(2)
+	bothRunning := make(chan struct{})
+	go func() {
+		middle.Wait()
+		close(bothRunning)
+	}()
+
+	var mu sync.Mutex
+	var order []string
+	received := make(map[string]map[string]interface{})
+	client := memoryClient(func(_ context.Context, message *A2AMessage) (*A2AResponse, error) {
+		tool := string(message.ToolName)
+		if tool == "b" || tool == "c" {
+			middle.Done()
+			select {
+			case <-bothRunning:
+			case <-time.After(5 * time.Second):
+				t.Errorf("stage %s ran alone", tool)
+			}
+		}
+		mu.Lock()
+		order = append(order, tool)
+		received[tool] = message.Parameters
+		mu.Unlock()
+		return &A2AResponse{Success: true, Result: tool}, nil
+	}, nil)
+
+	pipeline := PipelineCoordination{
+		Mode:             "pipeline",
+		Stages:           []PipelineStage{dagStage("d", "b", "c"), dagStage("b", "a"), dagStage("c", "a"), dagStage("a")},
+		FailureStrategy:  "abort",
+		StatePassthrough: true,
+	}
+	target := Utils.SingleTarget("agent-1")
+	trace, err := client.RunPipelineDAG(context.Background(), pipeline, PipelineOptions{Target: &target})
+	if err != nil {
+		t.Fatalf("RunPipelineDAG: %v", err)
+	}
+
+	if order[0] != "a" || order[3] != "d" {
+		t.Errorf("stages ran in order %v, want a first and d last", order)
+	}
+	if received["b"]["input"] != "a" || received["c"]["input"] != "a" {
+		t.Errorf("b and c received %v and %v, want a's output", received["b"], received["c"])
+	}
+	if want := map[string]interface{}{"b": "b", "c": "c"}; !reflect.DeepEqual(received["d"], want) {
+		t.Errorf("d received %v, want %v", received["d"], want)
+	}
+	for _, stage := range trace.Stages {
+		if stage.Status != StageStatusCompleted {
+			t.Errorf("stage %s is %s", stage.Name, stage.Status)
+		}
+	}
+}
+
+func TestRunPipelineDAGRejectsBeforeRunning(t *testing.T) {
+	tests := []struct {
+		name   string
+		stages []PipelineStage
+		code   string
+		cyclic []string
+	}{
+		{"cycle", []PipelineStage{dagStage("a", "c"), dagStage("b", "a"), dagStage("c", "b"), dagStage("d", "a")}, CodePipelineCycle, []string{"a", "b", "c"}},
+		{"self dependency", []PipelineStage{dagStage("a"), dagStage("b", "b")}, CodePipelineCycle, []string{"b"}},
+		{"unknown dependency", []PipelineStage{dagStage("a", "missing")}, CodeValidation, nil},
+		{"duplicate name", []PipelineStage{dagStage("a"), dagStage("a")}, CodeValidation, nil},
+		{"unnamed stage", []PipelineStage{dagStage("a"), {ToolName: "b"}, dagStage("c", "a")}, CodeValidation, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := memoryClient(func(context.Context, *A2AMessage) (*A2AResponse, error) {
+				t.Error("a stage ran")
+				return &A2AResponse{Success: true}, nil
+			}, nil)
+			target := Utils.SingleTarget("agent-1")
+			_, err := client.RunPipelineDAG(context.Background(),
+				PipelineCoordination{Mode: "pipeline", Stages: tt.stages, FailureStrategy: "abort"},
+				PipelineOptions{Target: &target})
+			if !HasCode(err, tt.code) {
+				t.Fatalf("got %v, want %s", err, tt.code)
+			}
+			if tt.cyclic != nil {
+				var clientErr *A2AClientError
+				if errors.As(err, &clientErr) && !reflect.DeepEqual(clientErr.Details, tt.cyclic) {
+					t.Errorf("cycle reported as %v, want %v", clientErr.Details, tt.cyclic)
+				}
+			}
+		})
+	}
+}
+
+func TestRunPipelineDAGAllowsUnnamedStagesWithoutDependencies(t *testing.T) {
+	client := memoryClient(nil, nil)
+	target := Utils.SingleTarget("agent-1")
+	_, err := client.RunPipelineDAG(context.Background(),
+		PipelineCoordination{Mode: "pipeline", Stages: []PipelineStage{{ToolName: "a"}, {ToolName: "b"}}, FailureStrategy: "abort"},
+		PipelineOptions{Target: &target})
+	if err != nil {
+		t.Errorf("RunPipelineDAG: %v", err)
+	}
+}
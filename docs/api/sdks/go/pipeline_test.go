@@ -0,0 +1,45 @@
+package a2aclient
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestRunPipelineTransformChain(t *testing.T) {
+	received := make(map[string]map[string]interface{})
+	client := memoryClient(func(_ context.Context, message *A2AMessage) (*A2AResponse, error) {
+		received[string(message.ToolName)] = message.Parameters
+		if message.ToolName == "extract" {
+			return &A2AResponse{Success: true, Result: map[string]interface{}{
+				"data": map[string]interface{}{"items": []interface{}{map[string]interface{}{"id": "x"}}},
+			}}, nil
+		}
+		return &A2AResponse{Success: true, Result: map[string]interface{}{"stored": message.Parameters["id"]}}, nil
+	}, nil)
+
+	pipeline := PipelineCoordination{
+		Mode: "pipeline",
+		Stages: []PipelineStage{
+			{Name: "extract", ToolName: "extract", OutputTransform: "$.data"},
+			{Name: "store", ToolName: "store", InputTransform: "$.items[0]", Parameters: map[string]interface{}{"mode": "fast"}},
+		},
+		StatePassthrough: true,
+	}
+	target := Utils.SingleTarget("agent-1")
+	trace, err := client.RunPipeline(context.Background(), pipeline, PipelineOptions{Target: &target})
+	if err != nil {
+		t.Fatalf("RunPipeline: %v", err)
+	}
+
+	wantItems := map[string]interface{}{"items": []interface{}{map[string]interface{}{"id": "x"}}}
+	if !reflect.DeepEqual(trace.Stages[0].Output, wantItems) {
+		t.Errorf("extract output %v, want %v after its output transform", trace.Stages[0].Output, wantItems)
+	}
+	if want := map[string]interface{}{"id": "x", "mode": "fast"}; !reflect.DeepEqual(received["store"], want) {
+		t.Errorf("store received %v, want %v", received["store"], want)
+	}
+	if want := map[string]interface{}{"stored": "x"}; !reflect.DeepEqual(trace.Stages[1].Output, want) {
+		t.Errorf("store output %v, want %v", trace.Stages[1].Output, want)
+	}
+}
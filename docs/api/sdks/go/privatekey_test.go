@@ -0,0 +1,110 @@
+package a2aclient
+
+import (
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+)
+
+// The fixtures in testdata were generated with OpenSSL, passphrase "secret":
+//
+//	openssl genrsa -traditional -aes256 -passout pass:secret -out client-pkcs1.key 2048
+//	openssl pkcs8 -topk8 -v2 aes-256-cbc -v2prf hmacWithSHA256 -passout pass:secret -in ec.pem -out client-pkcs8.key
+//
+// with self-signed certificates for each key.
+
+func TestLoadClientCertificateDecryptsKeys(t *testing.T) {
+	for _, format := range []string{"pkcs1", "pkcs8"} {
+		t.Run(format, func(t *testing.T) {
+			certificate := &A2ACertificate{
+				CertFile: "testdata/client-" + format + ".crt",
+				KeyFile:  "testdata/client-" + format + ".key",
+			}
+
+			certificate.Passphrase = "secret"
+			if _, err := loadClientCertificate(certificate); err != nil {
+				t.Fatalf("loadClientCertificate: %v", err)
+			}
+
+			certificate.Passphrase = "wrong"
+			_, err := loadClientCertificate(certificate)
+			if !errors.Is(err, x509.IncorrectPasswordError) {
+				t.Fatalf("err = %v, want x509.IncorrectPasswordError", err)
+			}
+		})
+	}
+}
+
+func TestDecryptPKCS8RejectsMalformedKeys(t *testing.T) {
+	keyPEM, err := os.ReadFile("testdata/client-pkcs8.key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, _ := pem.Decode(keyPEM)
+
+	// reencode rewrites the fixture after mutating its structure
+	reencode := func(t *testing.T, mutate func(info *encryptedPrivateKeyInfo, kdf *pbkdf2Params)) []byte {
+		t.Helper()
+		var info encryptedPrivateKeyInfo
+		var params pbes2Params
+		var kdf pbkdf2Params
+		if _, err := asn1.Unmarshal(block.Bytes, &info); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := asn1.Unmarshal(info.Algorithm.Parameters.FullBytes, &params); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := asn1.Unmarshal(params.KeyDerivationFunc.Parameters.FullBytes, &kdf); err != nil {
+			t.Fatal(err)
+		}
+
+		mutate(&info, &kdf)
+
+		kdfDER, err := asn1.Marshal(kdf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		params.KeyDerivationFunc.Parameters = asn1.RawValue{FullBytes: kdfDER}
+		paramsDER, err := asn1.Marshal(params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		info.Algorithm.Parameters = asn1.RawValue{FullBytes: paramsDER}
+		der, err := asn1.Marshal(info)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return der
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(info *encryptedPrivateKeyInfo, kdf *pbkdf2Params)
+		want   string
+	}{
+		{"empty data", func(info *encryptedPrivateKeyInfo, _ *pbkdf2Params) {
+			info.EncryptedData = []byte{}
+		}, "no encrypted data"},
+		{"truncated data", func(info *encryptedPrivateKeyInfo, _ *pbkdf2Params) {
+			info.EncryptedData = info.EncryptedData[:len(info.EncryptedData)-1]
+		}, "bad IV or data length"},
+		{"zero iterations", func(_ *encryptedPrivateKeyInfo, kdf *pbkdf2Params) {
+			kdf.IterationCount = 0
+		}, "iteration count"},
+		{"excessive iterations", func(_ *encryptedPrivateKeyInfo, kdf *pbkdf2Params) {
+			kdf.IterationCount = maxPBKDF2Iterations + 1
+		}, "iteration count"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := decryptPKCS8(reencode(t, tt.mutate), []byte("secret"))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("err = %v, want it to mention %q", err, tt.want)
+			}
+		})
+	}
+}
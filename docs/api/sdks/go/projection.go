@@ -0,0 +1,60 @@
+package a2aclient
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Project extracts the values at the given dotted paths from the response
+// result, keyed by path. Path segments index into objects by key and into
+// arrays by position (e.g. "agents.0.id"). Paths that do not resolve are
+// omitted.
+func (r *A2AResponse) Project(paths ...string) map[string]interface{} {
+	projected := make(map[string]interface{}, len(paths))
+
+	var result interface{}
+	if err := decodeMap(r.Result, &result); err != nil {
+		return projected
+	}
+
+	for _, path := range paths {
+		if value, ok := lookupPath(result, path); ok {
+			projected[path] = value
+		}
+	}
+	return projected
+}
+
+// lookupPath resolves a dotted path in a decoded JSON value
+func lookupPath(value interface{}, path string) (interface{}, bool) {
+	if path == "" {
+		return nil, false
+	}
+
+	return lookupSegments(value, strings.Split(path, "."))
+}
+
+// lookupSegments resolves a path given as its segments in a decoded JSON
+// value
+func lookupSegments(value interface{}, segments []string) (interface{}, bool) {
+	current := value
+	for _, segment := range segments {
+		switch v := current.(type) {
+		case map[string]interface{}:
+			next, ok := v[segment]
+			if !ok {
+				return nil, false
+			}
+			current = next
+		case []interface{}:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(v) {
+				return nil, false
+			}
+			current = v[index]
+		default:
+			return nil, false
+		}
+	}
+	return current, true
+}
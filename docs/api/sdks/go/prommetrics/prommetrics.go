@@ -0,0 +1,92 @@
+// Package prommetrics exposes A2A client metrics to Prometheus. It is a
+// module of its own, separate from the client module, so that only programs
+// importing it depend on the Prometheus client library.
+package prommetrics
+
+import (
+	"strconv"
+	"time"
+
+	a2aclient "github.com/gemini-flow/a2a-client-go"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics implements a2aclient.MetricsHook with Prometheus collectors
+type Metrics struct {
+	requests *prometheus.CounterVec
+	retries  *prometheus.CounterVec
+	latency  *prometheus.HistogramVec
+	errors   *prometheus.CounterVec
+	inFlight prometheus.Gauge
+}
+
+// New creates the client collectors under the given namespace and registers
+// them with registerer (prometheus.DefaultRegisterer if nil)
+func New(namespace string, registerer prometheus.Registerer) (*Metrics, error) {
+	if registerer == nil {
+		registerer = prometheus.DefaultRegisterer
+	}
+
+	m := &Metrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "a2a_messages_total",
+			Help:      "Messages sent, by tool and coordination mode.",
+		}, []string{"tool", "coordination"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "a2a_retries_total",
+			Help:      "Message retries, by tool and attempt number.",
+		}, []string{"tool", "attempt"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "a2a_request_duration_seconds",
+			Help:      "End-to-end message latency including retries.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"tool"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "a2a_errors_total",
+			Help:      "Failed messages, by tool and error code.",
+		}, []string{"tool", "code"}),
+		inFlight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "a2a_websocket_in_flight",
+			Help:      "WebSocket requests, streams and subscriptions awaiting responses.",
+		}),
+	}
+
+	for _, collector := range []prometheus.Collector{m.requests, m.retries, m.latency, m.errors, m.inFlight} {
+		if err := registerer.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// ObserveRequest counts a message by tool and coordination mode
+func (m *Metrics) ObserveRequest(tool a2aclient.MCPToolName, coordinationMode string) {
+	m.requests.WithLabelValues(string(tool), coordinationMode).Inc()
+}
+
+// ObserveRetry counts a retry by tool and attempt number
+func (m *Metrics) ObserveRetry(tool a2aclient.MCPToolName, attempt int) {
+	m.retries.WithLabelValues(string(tool), strconv.Itoa(attempt)).Inc()
+}
+
+// ObserveLatency records end-to-end message latency
+func (m *Metrics) ObserveLatency(tool a2aclient.MCPToolName, latency time.Duration) {
+	m.latency.WithLabelValues(string(tool)).Observe(latency.Seconds())
+}
+
+// IncError counts a failed message by tool and error code
+func (m *Metrics) IncError(tool a2aclient.MCPToolName, code string) {
+	m.errors.WithLabelValues(string(tool), code).Inc()
+}
+
+// ObserveInFlight sets the in-flight WebSocket request gauge
+func (m *Metrics) ObserveInFlight(count int) {
+	m.inFlight.Set(float64(count))
+}
+
+var _ a2aclient.MetricsHook = (*Metrics)(nil)
@@ -0,0 +1,45 @@
+package a2aclient
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// parseProxyURL parses a ProxyURL, accepting the schemes both the HTTP
+// transport and the WebSocket dialer support
+func parseProxyURL(raw string) (*url.URL, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		// The parse error quotes the URL, credentials included
+		return nil, errors.New("Proxy URL is not a valid URL")
+	}
+	if u.Scheme != "http" && u.Scheme != "socks5" {
+		return nil, fmt.Errorf("Proxy URL scheme must be http or socks5, got %q", u.Scheme)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("Proxy URL %q has no host", u.Redacted())
+	}
+	return u, nil
+}
+
+// proxyFunc returns the proxy selector for HTTP requests and WebSocket
+// dials: config.Proxy if set, otherwise one for ProxyURL, otherwise nil for
+// direct connections. If ProxyURL is invalid, the selector fails every
+// request rather than letting traffic bypass the proxy, and the parse error
+// is returned alongside it.
+func proxyFunc(config *A2AClientConfig) (func(*http.Request) (*url.URL, error), error) {
+	if config.Proxy != nil {
+		return config.Proxy, nil
+	}
+	if config.ProxyURL == "" {
+		return nil, nil
+	}
+	u, err := parseProxyURL(config.ProxyURL)
+	if err != nil {
+		err = NewA2AClientError(CodeValidation, err.Error(), nil)
+		return func(*http.Request) (*url.URL, error) { return nil, err }, err
+	}
+	return http.ProxyURL(u), nil
+}
@@ -0,0 +1,154 @@
+package a2aclient
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+// forwardProxy is an HTTP proxy recording the requests it forwards: plain
+// HTTP requests are relayed and CONNECT tunnels are piped to the target
+type forwardProxy struct {
+	*httptest.Server
+	mu   sync.Mutex
+	seen []string
+}
+
+func newForwardProxy(t *testing.T) *forwardProxy {
+	p := &forwardProxy{}
+	p.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p.mu.Lock()
+		p.seen = append(p.seen, r.Method+" "+r.Host)
+		p.mu.Unlock()
+
+		if r.Method == http.MethodConnect {
+			upstream, err := net.Dial("tcp", r.Host)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadGateway)
+				return
+			}
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err != nil {
+				upstream.Close()
+				return
+			}
+			io.WriteString(conn, "HTTP/1.1 200 Connection established\r\n\r\n")
+			go func() {
+				io.Copy(upstream, conn)
+				upstream.Close()
+			}()
+			go func() {
+				io.Copy(conn, upstream)
+				conn.Close()
+			}()
+			return
+		}
+
+		out := r.Clone(r.Context())
+		out.RequestURI = ""
+		resp, err := http.DefaultTransport.RoundTrip(out)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		defer resp.Body.Close()
+		for key, values := range resp.Header {
+			w.Header()[key] = values
+		}
+		w.WriteHeader(resp.StatusCode)
+		io.Copy(w, resp.Body)
+	}))
+	t.Cleanup(p.Close)
+	return p
+}
+
+func (p *forwardProxy) requests() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.seen...)
+}
+
+func TestProxyURLRoutesRequests(t *testing.T) {
+	server := newWSServer(t, func(_ *websocket.Conn, message *A2AMessage) *A2AResponse {
+		return echoResult(message)
+	})
+	server.http = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		io.WriteString(w, okResponse)
+	})
+	target := server.Listener.Addr().String()
+	proxy := newForwardProxy(t)
+
+	client := NewA2AClient(&A2AClientConfig{BaseURL: server.URL, ProxyURL: proxy.URL, RetryPolicy: fastRetries(0)})
+	if _, err := client.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowSwarmStatus, nil)); err != nil {
+		t.Fatalf("HTTP send through the proxy: %v", err)
+	}
+	if seen := proxy.requests(); len(seen) != 1 || seen[0] != "POST "+target {
+		t.Errorf("proxy saw %q, want the HTTP request to %s", seen, target)
+	}
+
+	wsClient := connectWS(t, server, func(config *A2AClientConfig) {
+		config.ProxyURL = proxy.URL
+		config.RetryPolicy = fastRetries(0)
+	})
+	if _, err := wsClient.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowSwarmStatus, nil)); err != nil {
+		t.Fatalf("WebSocket send through the proxy: %v", err)
+	}
+	if seen := proxy.requests(); len(seen) != 2 || seen[1] != "CONNECT "+target {
+		t.Errorf("proxy saw %q, want a CONNECT tunnel to %s", seen, target)
+	}
+}
+
+func TestProxyFuncOverridesProxyURL(t *testing.T) {
+	server := newWSServer(t, nil)
+	server.http = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		io.WriteString(w, okResponse)
+	})
+	proxy := newForwardProxy(t)
+	proxyURL, _ := url.Parse(proxy.URL)
+
+	var asked int
+	client := NewA2AClient(&A2AClientConfig{
+		BaseURL:     server.URL,
+		ProxyURL:    "http://unused.invalid:1",
+		RetryPolicy: fastRetries(0),
+		Proxy: func(*http.Request) (*url.URL, error) {
+			asked++
+			return proxyURL, nil
+		},
+	})
+	if _, err := client.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowSwarmStatus, nil)); err != nil {
+		t.Fatalf("SendMessage: %v", err)
+	}
+	if asked != 1 || len(proxy.requests()) != 1 {
+		t.Errorf("Proxy called %d times and the proxy saw %q, want one request through it", asked, proxy.requests())
+	}
+}
+
+func TestInvalidProxyURLNeverBypassesProxy(t *testing.T) {
+	var direct int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		direct++
+		io.WriteString(w, okResponse)
+	}))
+	defer server.Close()
+
+	config := &A2AClientConfig{BaseURL: server.URL, ProxyURL: "ftp://proxy.test", RetryPolicy: fastRetries(0)}
+	if _, err := NewA2AClientWithError(config); !HasCode(err, CodeValidation) {
+		t.Errorf("NewA2AClientWithError: got %v, want VALIDATION_ERROR", err)
+	}
+
+	client := NewA2AClient(&A2AClientConfig{BaseURL: server.URL, ProxyURL: "ftp://proxy.test", RetryPolicy: fastRetries(0)})
+	if _, err := client.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowSwarmStatus, nil)); err == nil {
+		t.Error("send succeeded with an invalid proxy")
+	}
+	if direct != 0 {
+		t.Errorf("server received %d requests directly, want none", direct)
+	}
+}
@@ -0,0 +1,29 @@
+package a2aclient
+
+import (
+	"context"
+	"fmt"
+)
+
+// RaceTargets sends toolName to every agent in agentIDs concurrently, one
+// direct message each, and returns the first successful response together
+// with the ID of the agent that sent it. The other sends are cancelled
+// through their context as soon as there is a winner; RaceTargets returns
+// once they have stopped, ignoring their late replies. If every agent fails,
+// an A2A_REQUEST_FAILED error is returned whose details hold each agent's
+// AgentOutcome.
+func (c *A2AClient) RaceTargets(ctx context.Context, agentIDs []string, toolName MCPToolName, params map[string]interface{}) (*A2AResponse, string, error) {
+	if len(agentIDs) == 0 {
+		return nil, "", NewA2AClientError(CodeValidation, "Race needs at least one agent", nil)
+	}
+
+	outcomes, winner := c.fanOut(ctx, agentIDs, toolName, params, true)
+	if winner >= 0 {
+		return outcomes[winner].Response, outcomes[winner].AgentID, nil
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, "", err
+	}
+	return nil, "", NewA2AClientError(CodeRequestFailed,
+		fmt.Sprintf("All %d race targets failed", len(agentIDs)), outcomes)
+}
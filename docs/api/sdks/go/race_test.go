@@ -0,0 +1,62 @@
+package a2aclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRaceTargetsCancelsSlowAgent(t *testing.T) {
+	// The fast agent answers once the slow one is waiting on its send
+	started := make(chan struct{})
+	cancelled := make(chan struct{})
+	client := memoryClient(func(ctx context.Context, message *A2AMessage) (*A2AResponse, error) {
+		if message.Target.SingleTarget.AgentID == "fast" {
+			<-started
+			return &A2AResponse{Success: true, Result: "fast"}, nil
+		}
+		close(started)
+		select {
+		case <-ctx.Done():
+			close(cancelled)
+			return nil, ctx.Err()
+		case <-time.After(10 * time.Second):
+			return &A2AResponse{Success: true, Result: "slow"}, nil
+		}
+	}, func(config *A2AClientConfig) {
+		config.RetryPolicy = fastRetries(0)
+	})
+
+	start := time.Now()
+	response, agentID, err := client.RaceTargets(context.Background(), []string{"slow", "fast"}, MCPToolClaudeFlowHealthCheck, nil)
+	if err != nil {
+		t.Fatalf("RaceTargets: %v", err)
+	}
+	if agentID != "fast" || response.Result != "fast" {
+		t.Errorf("winner %s with %v, want fast", agentID, response.Result)
+	}
+	select {
+	case <-cancelled:
+	default:
+		t.Error("slow agent's send was not cancelled")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("race took %v waiting for the slow agent", elapsed)
+	}
+}
+
+func TestRaceTargetsAllFail(t *testing.T) {
+	client := agentsClient()
+	_, _, err := client.RaceTargets(context.Background(), []string{"bad-1", "bad-2"}, MCPToolClaudeFlowHealthCheck, nil)
+	if !HasCode(err, CodeRequestFailed) {
+		t.Fatalf("got %v, want A2A_REQUEST_FAILED", err)
+	}
+	var clientErr *A2AClientError
+	if !errors.As(err, &clientErr) {
+		t.Fatal("not an *A2AClientError")
+	}
+	if outcomes, _ := clientErr.Details.([]AgentOutcome); len(outcomes) != 2 {
+		t.Errorf("details hold %v, want both outcomes", clientErr.Details)
+	}
+}
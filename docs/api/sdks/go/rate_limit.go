@@ -0,0 +1,122 @@
+package a2aclient
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// RateLimiter paces outgoing messages. *rate.Limiter from
+// golang.org/x/time/rate satisfies it, as can a distributed limiter.
+type RateLimiter interface {
+	// WaitN blocks until n sends are permitted or ctx is done
+	WaitN(ctx context.Context, n int) error
+}
+
+// RateLimitConfig configures the built-in token bucket limiter
+type RateLimitConfig struct {
+	RequestsPerSecond float64 `json:"requests_per_second"`
+	Burst             int     `json:"burst"` // default 1
+}
+
+// tokenBucket is the built-in RateLimiter
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  int
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(config RateLimitConfig) *tokenBucket {
+	return &tokenBucket{
+		rate:   config.RequestsPerSecond,
+		burst:  config.Burst,
+		tokens: float64(config.Burst),
+		last:   time.Now(),
+	}
+}
+
+// WaitN blocks until n tokens are available and takes them
+func (b *tokenBucket) WaitN(ctx context.Context, n int) error {
+	if n > b.burst {
+		return fmt.Errorf("rate limit: requested %d tokens exceeds burst of %d", n, b.burst)
+	}
+
+	for {
+		b.mu.Lock()
+		now := time.Now()
+		b.tokens = math.Min(float64(b.burst), b.tokens+now.Sub(b.last).Seconds()*b.rate)
+		b.last = now
+		if b.tokens >= float64(n) {
+			b.tokens -= float64(n)
+			b.mu.Unlock()
+			return nil
+		}
+		wait := time.Duration((float64(n) - b.tokens) / b.rate * float64(time.Second))
+		b.mu.Unlock()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// reset refills the bucket
+func (b *tokenBucket) reset() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tokens = float64(b.burst)
+	b.last = time.Now()
+}
+
+// rateReservation holds capacity taken ahead of time by ReserveRateLimit
+type rateReservation struct {
+	mu        sync.Mutex
+	remaining int
+}
+
+// take uses one reserved send, reporting false once the reservation is spent
+func (r *rateReservation) take() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.remaining == 0 {
+		return false
+	}
+	r.remaining--
+	return true
+}
+
+type rateReservationKey struct{}
+
+// ReserveRateLimit waits until n sends are permitted by the rate limiter and
+// returns a context carrying them. Messages sent with the returned context
+// use the reserved capacity before waiting on the limiter again, so a batch
+// can be admitted as a whole. Without a rate limiter it returns ctx unchanged.
+func (c *A2AClient) ReserveRateLimit(ctx context.Context, n int) (context.Context, error) {
+	if c.rateLimiter == nil {
+		return ctx, nil
+	}
+	if err := c.rateLimiter.WaitN(ctx, n); err != nil {
+		return nil, err
+	}
+	return context.WithValue(ctx, rateReservationKey{}, &rateReservation{remaining: n}), nil
+}
+
+// waitRateLimit blocks until the next send is permitted, drawing on any
+// reservation carried by ctx first
+func (c *A2AClient) waitRateLimit(ctx context.Context) error {
+	if c.rateLimiter == nil {
+		return nil
+	}
+	if reservation, ok := ctx.Value(rateReservationKey{}).(*rateReservation); ok && reservation.take() {
+		return nil
+	}
+	return c.rateLimiter.WaitN(ctx, 1)
+}
@@ -0,0 +1,131 @@
+package a2aclient
+
+import (
+	"context"
+	"time"
+)
+
+// ReconnectPolicy controls automatic WebSocket reconnection
+type ReconnectPolicy struct {
+	MaxAttempts     int           `json:"max_attempts"`
+	BackoffStrategy string        `json:"backoff_strategy"` // "linear", "exponential"
+	BaseDelay       time.Duration `json:"base_delay"`
+	MaxDelay        time.Duration `json:"max_delay"`
+}
+
+// handleConnectionLost is called when a connection's reader stops. If the
+// connection was still in the pool and was not closed by Disconnect, the loss
+// is reported. While other pooled connections remain, subscriptions on the
+// lost one move to a remaining connection and, when enabled, only the lost
+// one is re-dialed; once none remain the reconnect supervisor is started.
+// Requests awaiting a response on the connection fail with CONNECTION_LOST
+// once the reader's done channel closes, unless ReplayOnReconnect resends
+// them.
+func (c *A2AClient) handleConnectionLost(link *wsLink, err error) {
+	clientLost, ok := c.markConnectionLost(link)
+	if !ok {
+		return
+	}
+	c.deliverStateChanges()
+	if isStale(err) {
+		c.emitConnectionEvent(ConnectionEvent{Type: ConnectionEventStale, Err: err, Link: link.index})
+	}
+	c.emitConnectionEvent(ConnectionEvent{Type: ConnectionEventLost, Err: err, Link: link.index})
+
+	if !clientLost {
+		if remaining := c.nextLink(); remaining != nil {
+			go c.restoreSubscriptions(remaining)
+		}
+		if c.config.ReconnectEnabled {
+			go c.reconnectLink(link.index)
+		}
+		return
+	}
+	if c.config.ReconnectEnabled {
+		go c.reconnect()
+	}
+}
+
+// reconnect re-dials the WebSocket with the reconnect policy's backoff until
+// it succeeds, the attempts run out, or the connection is re-established or
+// closed by someone else. Subscriptions and buffered messages are restored
+// by ConnectOnce. If every attempt fails, messages buffered for reconnection
+// fail with CONNECTION_LOST.
+func (c *A2AClient) reconnect() {
+	policy := c.config.ReconnectPolicy
+	backoff := &RetryPolicy{
+		BackoffStrategy: policy.BackoffStrategy,
+		BaseDelay:       policy.BaseDelay,
+		MaxDelay:        policy.MaxDelay,
+	}
+
+	var lastErr error
+	for attempt := 0; attempt < policy.MaxAttempts; attempt++ {
+		if !c.waitBackoff(backoffDelay(backoff, attempt), c.awaitingReconnect) {
+			return
+		}
+
+		c.emitConnectionEvent(ConnectionEvent{Type: ConnectionEventAttempt, Attempt: attempt + 1})
+		ctx, cancel := context.WithTimeout(context.Background(), c.config.Timeout)
+		lastErr = c.connectOnce(ctx, false)
+		cancel()
+		if lastErr == nil {
+			c.emitConnectionEvent(ConnectionEvent{Type: ConnectionEventConnected, Attempt: attempt + 1})
+			return
+		}
+		c.emitConnectionEvent(ConnectionEvent{Type: ConnectionEventFailed, Attempt: attempt + 1, Err: lastErr})
+	}
+
+	c.connectionMux.Lock()
+	giveUp := c.connectionLost
+	c.connectionLost = false
+	if giveUp {
+		// Still connected if falling back to HTTP
+		if c.connected {
+			c.setState(ConnectionStateConnected)
+		} else {
+			c.setState(ConnectionStateDisconnected)
+		}
+	}
+	c.notifyLinksChanged()
+	c.connectionMux.Unlock()
+	c.deliverStateChanges()
+
+	if giveUp {
+		c.log("ERROR", "reconnection failed", "attempts", policy.MaxAttempts, "error", lastErr)
+	}
+	if giveUp && c.reconnectQueue != nil {
+		c.reconnectQueue.failAll(wrapError(CodeConnectionLost, "Reconnection failed before queued message was sent", lastErr))
+	}
+}
+
+// waitBackoff sleeps for delay, waking early whenever the pool's
+// connections change to check whether the wait still matters. It returns
+// false once waiting reports it does not, as after Disconnect or Shutdown.
+func (c *A2AClient) waitBackoff(delay time.Duration, waiting func() bool) bool {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	for {
+		c.connectionMux.RLock()
+		changed := c.linksChanged
+		c.connectionMux.RUnlock()
+
+		if !waiting() {
+			return false
+		}
+		select {
+		case <-timer.C:
+			return waiting()
+		case <-changed:
+		}
+	}
+}
+
+// awaitingReconnect reports whether the connection is still lost, as opposed
+// to re-established by Connect or closed by Disconnect
+func (c *A2AClient) awaitingReconnect() bool {
+	c.connectionMux.RLock()
+	defer c.connectionMux.RUnlock()
+	return c.connectionLost
+}
@@ -0,0 +1,228 @@
+package a2aclient
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ReconnectQueueConfig configures buffering of outgoing messages while the
+// client is re-establishing a lost connection. Buffered messages are flushed
+// in priority order once the connection is back.
+type ReconnectQueueConfig struct {
+	MaxSize        int    `json:"max_size"`
+	OverflowPolicy string `json:"overflow_policy"` // "drop-oldest", "reject"
+}
+
+// queuedMessage is a message waiting in the reconnect queue
+type queuedMessage struct {
+	ctx        context.Context
+	message    *A2AMessage
+	enqueuedAt time.Time
+	seq        uint64
+	result     chan queuedResult
+}
+
+// queuedResult carries the outcome of a flushed message back to its sender
+type queuedResult struct {
+	response *A2AResponse
+	err      error
+}
+
+// expiresAt returns when the message's TTL runs out, or the zero time if the
+// message has no TTL
+func (m *queuedMessage) expiresAt() time.Time {
+	if m.message.TTL == nil {
+		return time.Time{}
+	}
+	return m.enqueuedAt.Add(time.Duration(*m.message.TTL) * time.Second)
+}
+
+// complete delivers the result to the waiting sender without blocking
+func (m *queuedMessage) complete(response *A2AResponse, err error) {
+	select {
+	case m.result <- queuedResult{response: response, err: err}:
+	default:
+	}
+}
+
+// reconnectQueue is a bounded buffer of messages sent while disconnected
+type reconnectQueue struct {
+	mu      sync.Mutex
+	config  ReconnectQueueConfig
+	entries []*queuedMessage
+	seq     uint64
+}
+
+// newReconnectQueue creates a reconnect queue with the given configuration
+func newReconnectQueue(config ReconnectQueueConfig) *reconnectQueue {
+	return &reconnectQueue{config: config}
+}
+
+// enqueue adds a message to the queue, applying the overflow policy when full
+func (q *reconnectQueue) enqueue(entry *queuedMessage) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.entries) >= q.config.MaxSize {
+		if q.config.OverflowPolicy != "drop-oldest" {
+			return NewA2AClientError(CodeQueueFull, "Reconnect queue is full", q.config.MaxSize)
+		}
+		oldest := q.entries[0]
+		q.entries = q.entries[1:]
+		oldest.complete(nil, NewA2AClientError(CodeQueueFull, "Message dropped from full reconnect queue", q.config.MaxSize))
+	}
+
+	q.seq++
+	entry.seq = q.seq
+	q.entries = append(q.entries, entry)
+	return nil
+}
+
+// remove takes a single entry out of the queue, reporting whether it was
+// still queued
+func (q *reconnectQueue) remove(entry *queuedMessage) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for i, e := range q.entries {
+		if e == entry {
+			q.entries = append(q.entries[:i], q.entries[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// drain empties the queue, returning its entries highest priority first and
+// in arrival order within the same priority
+func (q *reconnectQueue) drain() []*queuedMessage {
+	q.mu.Lock()
+	entries := q.entries
+	q.entries = nil
+	q.mu.Unlock()
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		pi, pj := priorityRank(entries[i].message.Priority), priorityRank(entries[j].message.Priority)
+		if pi != pj {
+			return pi > pj
+		}
+		return entries[i].seq < entries[j].seq
+	})
+	return entries
+}
+
+// failAll empties the queue and fails every entry with err
+func (q *reconnectQueue) failAll(err error) {
+	q.mu.Lock()
+	entries := q.entries
+	q.entries = nil
+	q.mu.Unlock()
+
+	for _, entry := range entries {
+		entry.complete(nil, err)
+	}
+}
+
+// priorityRank orders message priorities, treating an unset priority as medium
+func priorityRank(priority *MessagePriority) int {
+	if priority == nil {
+		return 1
+	}
+	switch *priority {
+	case MessagePriorityCritical:
+		return 3
+	case MessagePriorityHigh:
+		return 2
+	case MessagePriorityLow:
+		return 0
+	default:
+		return 1
+	}
+}
+
+// enqueueIfReconnecting buffers the message when the reconnect queue is
+// enabled and the connection has been lost, unless FallbackToHTTP sends it
+// over HTTP instead. The check and the enqueue happen
+// under the connection lock so a concurrent Connect cannot flush in between.
+func (c *A2AClient) enqueueIfReconnecting(ctx context.Context, message *A2AMessage) (*queuedMessage, bool, error) {
+	if c.reconnectQueue == nil {
+		return nil, false, nil
+	}
+
+	c.connectionMux.RLock()
+	defer c.connectionMux.RUnlock()
+
+	if !c.connectionLost || c.config.FallbackToHTTP {
+		return nil, false, nil
+	}
+
+	entry := &queuedMessage{
+		ctx:        ctx,
+		message:    message,
+		enqueuedAt: time.Now(),
+		result:     make(chan queuedResult, 1),
+	}
+	if err := c.reconnectQueue.enqueue(entry); err != nil {
+		return nil, true, err
+	}
+	return entry, true, nil
+}
+
+// awaitQueuedMessage waits for a buffered message to be flushed, expiring it
+// with TTL_EXCEEDED if its TTL runs out while still queued
+func (c *A2AClient) awaitQueuedMessage(ctx context.Context, entry *queuedMessage) (*A2AResponse, error) {
+	var expired <-chan time.Time
+	if deadline := entry.expiresAt(); !deadline.IsZero() {
+		timer := time.NewTimer(time.Until(deadline))
+		defer timer.Stop()
+		expired = timer.C
+	}
+
+	for {
+		select {
+		case result := <-entry.result:
+			return result.response, result.err
+		case <-expired:
+			expired = nil
+			if c.reconnectQueue.remove(entry) {
+				err := NewA2AClientError(CodeTTLExceeded, "Message TTL expired while waiting for reconnection", entry.message.ID)
+				c.deadLetter(entry.message, err)
+				return nil, err
+			}
+			// Already being flushed; wait for the send to finish
+		case <-ctx.Done():
+			c.reconnectQueue.remove(entry)
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// flushReconnectQueue sends every buffered message in priority order,
+// passing along the remaining TTL of each. The senders are still waiting in
+// SendMessage, inside its interceptors, metrics and tracing, so only the
+// final transmit step is left and its response is cached there.
+func (c *A2AClient) flushReconnectQueue() {
+	for _, entry := range c.reconnectQueue.drain() {
+		if err := entry.ctx.Err(); err != nil {
+			entry.complete(nil, err)
+			continue
+		}
+
+		outgoing := entry.message
+		if deadline := entry.expiresAt(); !deadline.IsZero() {
+			remaining := time.Until(deadline)
+			if remaining <= 0 {
+				err := NewA2AClientError(CodeTTLExceeded, "Message TTL expired while waiting for reconnection", entry.message.ID)
+				c.deadLetter(entry.message, err)
+				entry.complete(nil, err)
+				continue
+			}
+			outgoing = refreshTTL(entry.message, deadline)
+		}
+
+		response, err := c.transmit(entry.ctx, outgoing)
+		entry.complete(response, err)
+	}
+}
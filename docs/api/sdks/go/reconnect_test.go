@@ -0,0 +1,148 @@
+package a2aclient
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestReconnectAfterServerDropsSocket(t *testing.T) {
+	arrived := make(chan struct{}, 1)
+	server := newWSServer(t, func(_ *websocket.Conn, message *A2AMessage) *A2AResponse {
+		if message.Parameters["hold"] == true {
+			// Never answered; the connection is dropped instead
+			arrived <- struct{}{}
+			return nil
+		}
+		return echoResult(message)
+	})
+	var dropped atomic.Bool
+	reconnected := make(chan struct{}, 1)
+	client := connectWS(t, server, func(config *A2AClientConfig) {
+		config.RetryPolicy = fastRetries(0)
+		config.ReconnectEnabled = true
+		config.ReconnectPolicy = &ReconnectPolicy{MaxAttempts: 5, BackoffStrategy: "linear", BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+		config.OnConnectionEvent = func(event ConnectionEvent) {
+			if event.Type == ConnectionEventConnected && dropped.Load() {
+				select {
+				case reconnected <- struct{}{}:
+				default:
+				}
+			}
+		}
+	})
+
+	errs := make(chan error, 1)
+	go func() {
+		_, err := client.SendMessage(context.Background(),
+			directMessage(MCPToolClaudeFlowSwarmStatus, map[string]interface{}{"hold": true}))
+		errs <- err
+	}()
+	<-arrived
+	dropped.Store(true)
+	server.dropConnections()
+
+	select {
+	case err := <-errs:
+		if !HasCode(err, CodeConnectionLost) {
+			t.Errorf("pending request got %v, want CONNECTION_LOST", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("pending request not failed after the socket dropped")
+	}
+
+	select {
+	case <-reconnected:
+	case <-time.After(5 * time.Second):
+		t.Fatal("client did not reconnect")
+	}
+	if _, err := client.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowSwarmStatus, nil)); err != nil {
+		t.Errorf("send after reconnecting: %v", err)
+	}
+	if got := server.connections(); got != 1 {
+		t.Errorf("server holds %d connections after reconnecting, want 1", got)
+	}
+}
+
+func TestDisconnectInterruptsReconnectBackoff(t *testing.T) {
+	server := newWSServer(t, func(_ *websocket.Conn, message *A2AMessage) *A2AResponse {
+		return echoResult(message)
+	})
+	client := connectWS(t, server, func(config *A2AClientConfig) {
+		config.ReconnectEnabled = true
+		config.ReconnectPolicy = &ReconnectPolicy{MaxAttempts: 3, BackoffStrategy: "linear", BaseDelay: time.Hour, MaxDelay: time.Hour}
+	})
+
+	server.dropConnections()
+	for deadline := time.Now().Add(5 * time.Second); !client.awaitingReconnect(); time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("client did not notice the dropped socket")
+		}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		client.reconnect()
+		close(done)
+	}()
+	client.Disconnect()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("reconnect kept sleeping after Disconnect")
+	}
+}
+
+func TestReplayOnReconnectResendsOnce(t *testing.T) {
+	var arrivals atomic.Int32
+	keys := make(chan string, 2)
+	arrived := make(chan struct{}, 1)
+	server := newWSServer(t, func(_ *websocket.Conn, message *A2AMessage) *A2AResponse {
+		keys <- message.ID + "/" + message.IdempotencyKey
+		if arrivals.Add(1) == 1 {
+			// The first delivery is never answered; the connection drops
+			arrived <- struct{}{}
+			return nil
+		}
+		return echoResult(message)
+	})
+	client := connectWS(t, server, func(config *A2AClientConfig) {
+		config.RetryPolicy = fastRetries(0)
+		config.ReconnectEnabled = true
+		config.ReconnectPolicy = &ReconnectPolicy{MaxAttempts: 5, BackoffStrategy: "linear", BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+		config.ReplayOnReconnect = true
+	})
+
+	message := directMessage(MCPToolClaudeFlowSwarmStatus, nil)
+	message.IdempotencyKey = "replay-1"
+	type result struct {
+		response *A2AResponse
+		err      error
+	}
+	results := make(chan result, 1)
+	go func() {
+		response, err := client.SendMessage(context.Background(), message)
+		results <- result{response, err}
+	}()
+	<-arrived
+	server.dropConnections()
+
+	select {
+	case r := <-results:
+		if r.err != nil || !r.response.Success {
+			t.Fatalf("replayed send got %+v, %v, want success", r.response, r.err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("request not replayed after reconnecting")
+	}
+	if got := arrivals.Load(); got != 2 {
+		t.Fatalf("server received the message %d times, want the original and one replay", got)
+	}
+	if original, replayed := <-keys, <-keys; replayed != original {
+		t.Errorf("replay sent as %s, want the original ID and key %s", replayed, original)
+	}
+}
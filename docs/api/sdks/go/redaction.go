@@ -0,0 +1,85 @@
+package a2aclient
+
+import (
+	"path"
+	"strings"
+)
+
+// redactedValue replaces sensitive values in logged payloads
+const redactedValue = "[REDACTED]"
+
+// alwaysRedactedKeys are redacted regardless of the configured policy when
+// they appear anywhere in a key. Keys are compared after lowercasing and
+// stripping "_" and "-", so "api_key", "accessToken" and "X-API-Key" all match.
+var alwaysRedactedKeys = []string{"token", "key", "password", "passphrase", "authorization"}
+
+// RedactionPolicy controls how sensitive values are masked before messages
+// and responses are logged
+type RedactionPolicy struct {
+	// KeyPatterns are case-insensitive glob patterns (e.g. "*token*",
+	// "password") matched against every map key in the payload
+	KeyPatterns []string `json:"key_patterns,omitempty"`
+	// Redact, when set, is called for every key that is not already redacted
+	// and returns the value to log in its place
+	Redact func(key string, value interface{}) interface{} `json:"-"`
+}
+
+// Apply returns a redacted copy of a decoded JSON value. The input is never
+// modified. A nil policy still redacts the always-sensitive keys.
+func (p *RedactionPolicy) Apply(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		redacted := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			switch {
+			case p.isSensitive(key):
+				redacted[key] = redactedValue
+			case p != nil && p.Redact != nil:
+				redacted[key] = p.Redact(key, p.Apply(item))
+			default:
+				redacted[key] = p.Apply(item)
+			}
+		}
+		return redacted
+	case []interface{}:
+		redacted := make([]interface{}, len(v))
+		for i, item := range v {
+			redacted[i] = p.Apply(item)
+		}
+		return redacted
+	default:
+		return value
+	}
+}
+
+// isSensitive reports whether the key is always redacted or matches one of
+// the policy's patterns
+func (p *RedactionPolicy) isSensitive(key string) bool {
+	lower := strings.ToLower(key)
+	normalized := strings.NewReplacer("_", "", "-", "").Replace(lower)
+	for _, sensitive := range alwaysRedactedKeys {
+		if strings.Contains(normalized, sensitive) {
+			return true
+		}
+	}
+
+	if p == nil {
+		return false
+	}
+	for _, pattern := range p.KeyPatterns {
+		if matched, _ := path.Match(strings.ToLower(pattern), lower); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// redactForLog converts a message, response, or other payload into its
+// generic JSON form and applies the policy, ready to be logged
+func (p *RedactionPolicy) redactForLog(payload interface{}) interface{} {
+	var generic interface{}
+	if err := decodeMap(payload, &generic); err != nil {
+		return redactedValue
+	}
+	return p.Apply(generic)
+}
@@ -0,0 +1,70 @@
+package a2aclient
+
+import (
+	"context"
+	"time"
+)
+
+// replayLink decides what happens to a request whose connection dropped
+// before its response arrived. With ReplayOnReconnect it waits for a live
+// pooled connection and returns it so the request can be resent; otherwise,
+// or if the message may not be replayed, it returns CONNECTION_LOST.
+func (c *A2AClient) replayLink(ctx context.Context, message *A2AMessage, timeout <-chan time.Time) (*wsLink, error) {
+	lost := NewA2AClientError(CodeConnectionLost, "WebSocket connection lost before the response arrived", message.ID)
+	if !c.config.ReplayOnReconnect || (c.config.ReplayStrict && !replaySafe(message)) {
+		return nil, lost
+	}
+
+	select {
+	case c.replaySlots <- struct{}{}:
+		defer func() { <-c.replaySlots }()
+	default:
+		// Replay buffer full
+		return nil, lost
+	}
+
+	for {
+		c.connectionMux.RLock()
+		changed := c.linksChanged
+		reconnecting := c.connectionLost
+		c.connectionMux.RUnlock()
+
+		if link := c.nextLink(); link != nil && link.alive() {
+			if err := checkExpired(message); err != nil {
+				return nil, err
+			}
+			c.log("DEBUG", "replaying message after connection loss", "id", message.ID)
+			return link, nil
+		}
+		if !reconnecting || !c.config.ReconnectEnabled {
+			return nil, lost
+		}
+
+		select {
+		case <-changed:
+		case <-timeout:
+			return nil, NewA2AClientError(CodeTimeout, "WebSocket message timeout", nil)
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// replaySafe reports whether repeating a message cannot multiply its effect
+// beyond what its idempotency key guards against: only direct or
+// uncoordinated messages qualify
+func replaySafe(message *A2AMessage) bool {
+	switch coordinationMode(message.Coordination) {
+	case "direct", "none":
+		return true
+	default:
+		return false
+	}
+}
+
+// notifyLinksChanged wakes requests waiting for a connection to replay on.
+// The caller must hold connectionMux for writing.
+func (c *A2AClient) notifyLinksChanged() {
+	close(c.linksChanged)
+	c.linksChanged = make(chan struct{})
+}
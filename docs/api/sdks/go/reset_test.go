@@ -0,0 +1,87 @@
+package a2aclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestResetFailsPendingRequests(t *testing.T) {
+	arrived := make(chan struct{})
+	server := newWSServer(t, func(_ *websocket.Conn, message *A2AMessage) *A2AResponse {
+		close(arrived)
+		return nil
+	})
+	client := connectWS(t, server, func(config *A2AClientConfig) {
+		config.RetryPolicy = fastRetries(0)
+	})
+
+	errs := make(chan error, 1)
+	go func() {
+		_, err := client.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowSwarmStatus, nil))
+		errs <- err
+	}()
+	<-arrived
+	client.Reset()
+
+	select {
+	case err := <-errs:
+		if !HasCode(err, CodeClientReset) {
+			t.Errorf("got %v, want CLIENT_RESET", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("pending request not failed by Reset")
+	}
+	if n := client.pendingRequests(); n != 0 {
+		t.Errorf("%d requests still pending", n)
+	}
+}
+
+func TestResetDropsOfflineQueue(t *testing.T) {
+	var dropped []error
+	client := NewA2AClient(&A2AClientConfig{
+		BaseURL:          "http://a2a.test",
+		QueueWhenOffline: true,
+		OnQueuedResult: func(_ *A2AMessage, _ *A2AResponse, err error) {
+			dropped = append(dropped, err)
+		},
+	})
+	for i := 0; i < 2; i++ {
+		client.config.OfflineQueue.Enqueue(OfflineMessage{Message: directMessage(MCPToolClaudeFlowSwarmStatus, nil)})
+	}
+
+	client.Reset()
+	if len(dropped) != 2 {
+		t.Fatalf("%d queued messages reported, want 2", len(dropped))
+	}
+	for _, err := range dropped {
+		if !HasCode(err, CodeClientReset) {
+			t.Errorf("got %v, want CLIENT_RESET", err)
+		}
+	}
+	if messages, _ := client.config.OfflineQueue.Drain(); len(messages) != 0 {
+		t.Errorf("%d messages left in the offline queue", len(messages))
+	}
+}
+
+func TestResetClearsEndpointHealthAndRateLimit(t *testing.T) {
+	client := NewA2AClient(&A2AClientConfig{
+		BaseURLs:  []string{"http://a.test", "http://b.test"},
+		RateLimit: &RateLimitConfig{RequestsPerSecond: 0.001, Burst: 1},
+	})
+	for i := 0; i < 10; i++ {
+		client.endpoints.recordFailure("http://a.test")
+	}
+	bucket := client.rateLimiter.(*tokenBucket)
+	bucket.tokens = 0
+
+	client.Reset()
+	if !client.endpoints.healthy("http://a.test", time.Now()) {
+		t.Error("endpoint failures survived Reset")
+	}
+	if bucket.tokens != 1 {
+		t.Errorf("rate limiter has %v tokens after Reset, want 1", bucket.tokens)
+	}
+}
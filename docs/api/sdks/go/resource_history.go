@@ -0,0 +1,136 @@
+package a2aclient
+
+import (
+	"context"
+	"time"
+)
+
+// Resource types reported in agent resource history
+const (
+	ResourceCPU    = "cpu"
+	ResourceMemory = "memory"
+	ResourceGPU    = "gpu"
+)
+
+// Sample is a single resource usage measurement
+type Sample struct {
+	Timestamp time.Time
+	Value     float64
+}
+
+// ResourceHistory is an agent's resource usage over time. Resources that
+// were not requested or not reported are empty.
+type ResourceHistory struct {
+	CPU    []Sample
+	Memory []Sample
+	GPU    []Sample
+}
+
+// GetResourceHistory fetches an agent's resource usage time series from
+// agent_metrics. Passing resource types (ResourceCPU, ResourceMemory,
+// ResourceGPU) limits the response to those series; none returns all.
+func (c *A2AClient) GetResourceHistory(ctx context.Context, agentID string, resources ...string) (*ResourceHistory, error) {
+	params := map[string]interface{}{
+		"agentId": agentID,
+		"metrics": "resource_history",
+	}
+	if len(resources) > 0 {
+		params["resources"] = resources
+	}
+
+	message := &A2AMessage{
+		Target:     Utils.SingleTarget(agentID),
+		ToolName:   MCPToolClaudeFlowAgentMetrics,
+		Parameters: params,
+		Coordination: CoordinationMode{
+			DirectCoordination: &DirectCoordination{
+				Mode: "direct",
+			},
+		},
+	}
+
+	response, err := c.SendMessage(ctx, message)
+	if err != nil {
+		return nil, err
+	}
+	if err := responseError(response); err != nil {
+		return nil, err
+	}
+	return response.ResourceHistory(), nil
+}
+
+// ResourceHistory decodes the resource usage series from an agent_metrics
+// response. The series may be under "resource_history" or at the top level
+// of the result, and samples may be [timestamp, value] pairs or objects with
+// "timestamp" and "value". Returns nil if the response has no series.
+func (r *A2AResponse) ResourceHistory() *ResourceHistory {
+	result, ok := r.Result.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	if nested, ok := result["resource_history"].(map[string]interface{}); ok {
+		result = nested
+	}
+
+	history := &ResourceHistory{
+		CPU:    decodeSamples(result[ResourceCPU]),
+		Memory: decodeSamples(result[ResourceMemory]),
+		GPU:    decodeSamples(result[ResourceGPU]),
+	}
+	if history.CPU == nil && history.Memory == nil && history.GPU == nil {
+		return nil
+	}
+	return history
+}
+
+// decodeSamples decodes a series of samples, skipping malformed entries
+func decodeSamples(value interface{}) []Sample {
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	samples := make([]Sample, 0, len(items))
+	for _, item := range items {
+		var timestamp, sampleValue interface{}
+		switch v := item.(type) {
+		case []interface{}:
+			if len(v) != 2 {
+				continue
+			}
+			timestamp, sampleValue = v[0], v[1]
+		case map[string]interface{}:
+			timestamp, sampleValue = v["timestamp"], v["value"]
+		default:
+			continue
+		}
+
+		at, ok := parseTimestamp(timestamp)
+		if !ok {
+			continue
+		}
+		number, ok := sampleValue.(float64)
+		if !ok {
+			continue
+		}
+		samples = append(samples, Sample{Timestamp: at, Value: number})
+	}
+	return samples
+}
+
+// parseTimestamp decodes a timestamp given as Unix seconds or an RFC 3339
+// string
+func parseTimestamp(value interface{}) (time.Time, bool) {
+	switch v := value.(type) {
+	case float64:
+		return time.Unix(0, int64(v*float64(time.Second))), true
+	case string:
+		parsed, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return time.Time{}, false
+		}
+		return parsed, true
+	default:
+		return time.Time{}, false
+	}
+}
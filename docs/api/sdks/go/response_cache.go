@@ -0,0 +1,256 @@
+package a2aclient
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// ResponseCacheConfig enables caching of responses to read-only tools.
+// Cached reads that depend on a memory namespace are dropped whenever a
+// message writing to that namespace is sent.
+type ResponseCacheConfig struct {
+	TTL        time.Duration `json:"ttl"`                   // default 30s
+	Tools      []MCPToolName `json:"tools,omitempty"`       // default DefaultCacheableTools
+	MaxEntries int           `json:"max_entries,omitempty"` // size of the default store, default 1000
+	// Store holds the cached responses (default an in-memory LRU of
+	// MaxEntries)
+	Store ResponseCache `json:"-"`
+}
+
+// DefaultCacheableTools are the read-only tools cached when
+// ResponseCacheConfig.Tools is empty. Swarm status is left out: it is
+// polled for changes, which a cached reply would hide.
+var DefaultCacheableTools = []MCPToolName{
+	MCPToolClaudeFlowAgentList,
+	MCPToolClaudeFlowMemoryUsage,
+	MCPToolClaudeFlowPerformanceReport,
+}
+
+type noCacheKey struct{}
+
+// WithoutCache returns a context whose reads skip the response cache and
+// go to the server, e.g. to poll for a change. Their responses still
+// refresh the cache.
+func WithoutCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noCacheKey{}, true)
+}
+
+// cacheBypassed reports whether ctx came from WithoutCache
+func cacheBypassed(ctx context.Context) bool {
+	bypassed, _ := ctx.Value(noCacheKey{}).(bool)
+	return bypassed
+}
+
+// ResponseCacheKey identifies a cached read by tool and canonical
+// parameters. Namespace is the memory namespace the read depends on, if
+// any, so that writes to it can invalidate the entry.
+type ResponseCacheKey struct {
+	Tool      MCPToolName
+	Namespace string
+	Params    string
+}
+
+// ResponseCache stores responses to read-only messages. Implementations must
+// be safe for concurrent use.
+type ResponseCache interface {
+	// Get returns the response stored under key if it has not expired
+	Get(key ResponseCacheKey) (*A2AResponse, bool)
+	// Set stores a response under key for ttl
+	Set(key ResponseCacheKey, response *A2AResponse, ttl time.Duration)
+	// Invalidate removes every entry whose key match reports true
+	Invalidate(match func(ResponseCacheKey) bool)
+}
+
+// MemoryResponseCache is an in-memory ResponseCache that evicts the least
+// recently used entry once full
+type MemoryResponseCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	order      *list.List // most recently used first
+	entries    map[ResponseCacheKey]*list.Element
+}
+
+type responseCacheEntry struct {
+	key       ResponseCacheKey
+	response  *A2AResponse
+	expiresAt time.Time
+}
+
+// NewMemoryResponseCache creates an in-memory cache holding at most
+// maxEntries responses
+func NewMemoryResponseCache(maxEntries int) *MemoryResponseCache {
+	return &MemoryResponseCache{
+		maxEntries: maxEntries,
+		order:      list.New(),
+		entries:    make(map[ResponseCacheKey]*list.Element),
+	}
+}
+
+// Get returns the response stored under key if it has not expired
+func (c *MemoryResponseCache) Get(key ResponseCacheKey) (*A2AResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := element.Value.(*responseCacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		c.order.Remove(element)
+		delete(c.entries, key)
+		return nil, false
+	}
+	c.order.MoveToFront(element)
+	return entry.response, true
+}
+
+// Set stores a response under key for ttl, evicting the least recently used
+// entry if the cache is full
+func (c *MemoryResponseCache) Set(key ResponseCacheKey, response *A2AResponse, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &responseCacheEntry{key: key, response: response, expiresAt: time.Now().Add(ttl)}
+	if element, ok := c.entries[key]; ok {
+		element.Value = entry
+		c.order.MoveToFront(element)
+		return
+	}
+	c.entries[key] = c.order.PushFront(entry)
+	for c.order.Len() > c.maxEntries {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*responseCacheEntry).key)
+	}
+}
+
+// Invalidate removes every entry whose key match reports true
+func (c *MemoryResponseCache) Invalidate(match func(ResponseCacheKey) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, element := range c.entries {
+		if match(key) {
+			c.order.Remove(element)
+			delete(c.entries, key)
+		}
+	}
+}
+
+// readActions are the "action" parameter values of read-only calls to tools
+// that also write, such as memory_usage
+var readActions = map[string]bool{"retrieve": true, "retrieve_batch": true, "list": true, "search": true}
+
+// responseCacheKey returns the cache key for a message, or false if the
+// message is not a cacheable read
+func (c *A2AClient) responseCacheKey(message *A2AMessage) (ResponseCacheKey, bool) {
+	config := c.config.ResponseCache
+	if config == nil {
+		return ResponseCacheKey{}, false
+	}
+
+	cacheable := false
+	for _, tool := range config.Tools {
+		if tool == message.ToolName {
+			cacheable = true
+			break
+		}
+	}
+	if !cacheable || len(writtenNamespaces(message)) > 0 {
+		return ResponseCacheKey{}, false
+	}
+	if action, ok := message.Parameters["action"].(string); ok && !readActions[action] {
+		return ResponseCacheKey{}, false
+	}
+
+	// Maps marshal with sorted keys, which makes the parameters canonical
+	params, err := json.Marshal(message.Parameters)
+	if err != nil {
+		return ResponseCacheKey{}, false
+	}
+
+	key := ResponseCacheKey{Tool: message.ToolName, Params: string(params)}
+	if namespace, ok := message.Parameters["namespace"].(string); ok {
+		key.Namespace = namespace
+	}
+	for _, requirement := range message.StateRequirements {
+		if requirement.Type == "read" && requirement.Namespace != "" {
+			key.Namespace = requirement.Namespace
+			break
+		}
+	}
+	return key, true
+}
+
+// cachedResponse returns a copy of the cached response to a read message
+func (c *A2AClient) cachedResponse(key ResponseCacheKey) (*A2AResponse, bool) {
+	response, ok := c.config.ResponseCache.Store.Get(key)
+	if !ok {
+		return nil, false
+	}
+	copied := *response
+	return &copied, true
+}
+
+// cacheResponse stores a successful response to a read message
+func (c *A2AClient) cacheResponse(key ResponseCacheKey, response *A2AResponse) {
+	if !response.Success {
+		return
+	}
+	copied := *response
+	c.config.ResponseCache.Store.Set(key, &copied, c.config.ResponseCache.TTL)
+}
+
+// writtenNamespaces returns the memory namespaces a message writes to
+func writtenNamespaces(message *A2AMessage) []string {
+	var namespaces []string
+	for _, requirement := range message.StateRequirements {
+		if requirement.Type == "write" && requirement.Namespace != "" {
+			namespaces = append(namespaces, requirement.Namespace)
+		}
+	}
+	return namespaces
+}
+
+// invalidateWrites drops cached reads of the namespaces a message writes to
+func (c *A2AClient) invalidateWrites(message *A2AMessage) {
+	if c.config.ResponseCache == nil {
+		return
+	}
+	namespaces := writtenNamespaces(message)
+	if len(namespaces) == 0 {
+		return
+	}
+	c.config.ResponseCache.Store.Invalidate(func(key ResponseCacheKey) bool {
+		for _, namespace := range namespaces {
+			if key.Namespace == namespace {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// InvalidateCache drops cached responses of the given tools, or of every
+// tool if none are given. It only affects the client's ResponseCache; use
+// InvalidateCacheBatch or InvalidateNamespace for the server's memory cache.
+func (c *A2AClient) InvalidateCache(tools ...MCPToolName) {
+	if c.config.ResponseCache == nil {
+		return
+	}
+	c.config.ResponseCache.Store.Invalidate(func(key ResponseCacheKey) bool {
+		if len(tools) == 0 {
+			return true
+		}
+		for _, tool := range tools {
+			if key.Tool == tool {
+				return true
+			}
+		}
+		return false
+	})
+}
@@ -0,0 +1,72 @@
+package a2aclient
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDefaultCacheableToolsExcludeSwarmStatus(t *testing.T) {
+	for _, tool := range DefaultCacheableTools {
+		if tool == MCPToolClaudeFlowSwarmStatus {
+			t.Fatal("swarm_status is cached by default")
+		}
+	}
+}
+
+func TestResponseCacheServesRepeatedReads(t *testing.T) {
+	var sends int32
+	client := memoryClient(func(context.Context, *A2AMessage) (*A2AResponse, error) {
+		return &A2AResponse{Success: true, Result: atomic.AddInt32(&sends, 1)}, nil
+	}, func(config *A2AClientConfig) {
+		config.ResponseCache = &ResponseCacheConfig{TTL: time.Minute}
+	})
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		if _, err := client.SendMessage(ctx, directMessage(MCPToolClaudeFlowAgentList, nil)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if sends != 1 {
+		t.Errorf("cached reads reached the server %d times, want 1", sends)
+	}
+
+	response, err := client.SendMessage(WithoutCache(ctx), directMessage(MCPToolClaudeFlowAgentList, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sends != 2 {
+		t.Errorf("WithoutCache read was served from the cache")
+	}
+	cached, err := client.SendMessage(ctx, directMessage(MCPToolClaudeFlowAgentList, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cached.Result != response.Result {
+		t.Errorf("cache not refreshed by the bypassing read: got %v, want %v", cached.Result, response.Result)
+	}
+}
+
+func TestWaitForSwarmReadyBypassesCache(t *testing.T) {
+	var polls int32
+	client := memoryClient(func(_ context.Context, message *A2AMessage) (*A2AResponse, error) {
+		status := "initializing"
+		if atomic.AddInt32(&polls, 1) > 1 {
+			status = "ready"
+		}
+		return &A2AResponse{Success: true, Result: map[string]interface{}{"status": status}}, nil
+	}, func(config *A2AClientConfig) {
+		config.ResponseCache = &ResponseCacheConfig{
+			TTL:   time.Minute,
+			Tools: []MCPToolName{MCPToolClaudeFlowSwarmStatus},
+		}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := client.WaitForSwarmReady(ctx, "swarm-1"); err != nil {
+		t.Fatalf("WaitForSwarmReady: %v", err)
+	}
+}
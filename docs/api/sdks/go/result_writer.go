@@ -0,0 +1,247 @@
+package a2aclient
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// SendMessageToWriter sends a message over HTTP and copies the raw JSON of the
+// response result to w as it arrives instead of decoding it, for tools such
+// as workflow_export and memory_backup whose results should not be held in
+// memory. The rest of the response is decoded as usual and its metadata
+// returned, together with an error if the response reports a failure. HTTP is
+// used even when a WebSocket is connected, and the message is sent once
+// without retries since a partly written result cannot be rewound.
+func (c *A2AClient) SendMessageToWriter(ctx context.Context, message *A2AMessage, w io.Writer) (*ResponseMetadata, error) {
+	c.prepareMessage(message)
+	c.logRequest(message)
+	start := time.Now()
+
+	// The result is split out of the JSON envelope, whatever the codec
+	resp, err := c.post(ctx, "/api/v2/a2a/message", "application/json", message)
+	if err != nil {
+		c.logResponse(message, nil, err, time.Since(start))
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	result := &limitedWriter{w: w, remaining: c.config.MaxStreamedResultBytes}
+	var envelope bytes.Buffer
+	if err := splitResult(bufio.NewReader(resp.Body), &envelope, result); err != nil {
+		if result.exceeded {
+			err = NewA2AClientError(CodeResponseTooLarge,
+				fmt.Sprintf("Result exceeds the %d byte limit", c.config.MaxStreamedResultBytes), c.config.MaxStreamedResultBytes)
+		} else {
+			err = transportError(ctx, "read response body", err)
+		}
+		c.logResponse(message, nil, err, time.Since(start))
+		return nil, err
+	}
+
+	var response A2AResponse
+	if err := json.Unmarshal(envelope.Bytes(), &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	c.logResponse(message, &response, nil, time.Since(start))
+
+	return &response.Metadata, responseError(&response)
+}
+
+// limitedWriter fails once more than remaining bytes have been written
+type limitedWriter struct {
+	w         io.Writer
+	remaining int64
+	exceeded  bool
+}
+
+func (l *limitedWriter) Write(p []byte) (int, error) {
+	if int64(len(p)) > l.remaining {
+		l.exceeded = true
+		return 0, fmt.Errorf("write limit exceeded")
+	}
+	l.remaining -= int64(len(p))
+	return l.w.Write(p)
+}
+
+// splitResult reads a JSON response object, copying the value of its
+// top-level "result" field to result and the rest of the object, with the
+// result replaced by null, to envelope
+func splitResult(r *bufio.Reader, envelope, result io.Writer) error {
+	if err := expectByte(r, '{'); err != nil {
+		return err
+	}
+	envelope.Write([]byte{'{'})
+
+	for {
+		b, err := nextNonSpace(r)
+		if err != nil {
+			return err
+		}
+		if b == '}' {
+			envelope.Write([]byte{'}'})
+			return nil
+		}
+		if b != '"' {
+			return fmt.Errorf("invalid response: expected field name, got %q", b)
+		}
+
+		var rawKey bytes.Buffer
+		rawKey.WriteByte('"')
+		if err := copyString(r, &rawKey); err != nil {
+			return err
+		}
+		var key string
+		if err := json.Unmarshal(rawKey.Bytes(), &key); err != nil {
+			return fmt.Errorf("invalid response field name: %w", err)
+		}
+		envelope.Write(rawKey.Bytes())
+
+		if err := expectByte(r, ':'); err != nil {
+			return err
+		}
+		envelope.Write([]byte{':'})
+
+		if key == "result" {
+			if err := copyValue(r, result); err != nil {
+				return err
+			}
+			envelope.Write([]byte("null"))
+		} else if err := copyValue(r, envelope); err != nil {
+			return err
+		}
+
+		b, err = nextNonSpace(r)
+		if err != nil {
+			return err
+		}
+		switch b {
+		case ',':
+			envelope.Write([]byte{','})
+		case '}':
+			envelope.Write([]byte{'}'})
+			return nil
+		default:
+			return fmt.Errorf("invalid response: unexpected %q after field %q", b, key)
+		}
+	}
+}
+
+// copyValue copies one JSON value from r to w without decoding it
+func copyValue(r *bufio.Reader, w io.Writer) error {
+	b, err := nextNonSpace(r)
+	if err != nil {
+		return err
+	}
+	out := bufio.NewWriter(w)
+
+	if b == '"' {
+		out.WriteByte(b)
+		if err := copyString(r, out); err != nil {
+			return err
+		}
+		return out.Flush()
+	}
+
+	if b == '{' || b == '[' {
+		out.WriteByte(b)
+		depth := 1
+		for depth > 0 {
+			b, err := r.ReadByte()
+			if err != nil {
+				return unexpectedEOF(err)
+			}
+			if err := out.WriteByte(b); err != nil {
+				return err
+			}
+			switch b {
+			case '"':
+				if err := copyString(r, out); err != nil {
+					return err
+				}
+			case '{', '[':
+				depth++
+			case '}', ']':
+				depth--
+			}
+		}
+		return out.Flush()
+	}
+
+	// Number, true, false or null: copy up to the next delimiter
+	out.WriteByte(b)
+	for {
+		next, err := r.Peek(1)
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		switch next[0] {
+		case ',', '}', ']', ' ', '\t', '\r', '\n':
+			return out.Flush()
+		}
+		r.ReadByte()
+		out.WriteByte(next[0])
+	}
+}
+
+// copyString copies the remainder of a JSON string, after its opening quote,
+// through the closing quote
+func copyString(r *bufio.Reader, w io.ByteWriter) error {
+	escaped := false
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		if err := w.WriteByte(b); err != nil {
+			return err
+		}
+		switch {
+		case escaped:
+			escaped = false
+		case b == '\\':
+			escaped = true
+		case b == '"':
+			return nil
+		}
+	}
+}
+
+// expectByte skips whitespace and consumes the expected byte
+func expectByte(r *bufio.Reader, want byte) error {
+	b, err := nextNonSpace(r)
+	if err != nil {
+		return err
+	}
+	if b != want {
+		return fmt.Errorf("invalid response: expected %q, got %q", want, b)
+	}
+	return nil
+}
+
+// nextNonSpace returns the next byte that is not JSON whitespace
+func nextNonSpace(r *bufio.Reader) (byte, error) {
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, unexpectedEOF(err)
+		}
+		switch b {
+		case ' ', '\t', '\r', '\n':
+			continue
+		}
+		return b, nil
+	}
+}
+
+// unexpectedEOF reports a body that ends mid-object as io.ErrUnexpectedEOF
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
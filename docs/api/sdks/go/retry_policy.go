@@ -0,0 +1,49 @@
+package a2aclient
+
+import "strings"
+
+// retryPolicyProblems lists what is wrong with a per-message retry policy
+func retryPolicyProblems(policy *RetryPolicy) []string {
+	var problems []string
+	if policy.MaxRetries < 0 {
+		problems = append(problems, "Retry policy max retries must not be negative")
+	}
+	if policy.MaxRetries > 0 && policy.BaseDelay <= 0 && policy.BackoffStrategy != "custom" {
+		problems = append(problems, "Retry policy base delay must be positive")
+	}
+	if policy.MaxDelay < 0 {
+		problems = append(problems, "Retry policy max delay must not be negative")
+	}
+	if policy.TotalTimeout < 0 {
+		problems = append(problems, "Retry policy total timeout must not be negative")
+	}
+	return problems
+}
+
+// validateRetryPolicy checks a message's own retry policy, if any
+func validateRetryPolicy(message *A2AMessage) error {
+	if message.RetryPolicy == nil {
+		return nil
+	}
+	if problems := retryPolicyProblems(message.RetryPolicy); len(problems) > 0 {
+		return NewA2AClientError(CodeValidation, strings.Join(problems, "; "), message.RetryPolicy)
+	}
+	return nil
+}
+
+// retryPolicy returns the policy to retry message with: its own RetryPolicy
+// if set, with a zero MaxDelay and nil RetryableErrors taken from the client
+// policy, otherwise the client policy
+func (c *A2AClient) retryPolicy(message *A2AMessage) *RetryPolicy {
+	if message.RetryPolicy == nil {
+		return c.config.RetryPolicy
+	}
+	policy := *message.RetryPolicy
+	if policy.MaxDelay == 0 {
+		policy.MaxDelay = c.config.RetryPolicy.MaxDelay
+	}
+	if policy.RetryableErrors == nil {
+		policy.RetryableErrors = c.config.RetryPolicy.RetryableErrors
+	}
+	return &policy
+}
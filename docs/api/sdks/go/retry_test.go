@@ -0,0 +1,410 @@
+package a2aclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetriesDroppedConnections(t *testing.T) {
+	const failures = 2
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) <= failures {
+			// Drop the connection without a response
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err == nil {
+				conn.Close()
+			}
+			return
+		}
+		io.WriteString(w, okResponse)
+	}))
+	defer server.Close()
+
+	client := NewA2AClient(&A2AClientConfig{BaseURL: server.URL, RetryPolicy: fastRetries(failures)})
+	response, err := client.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowAgentList, nil))
+	if err != nil {
+		t.Fatalf("SendMessage: %v", err)
+	}
+	if !response.Success {
+		t.Errorf("got unsuccessful response %+v", response)
+	}
+	if got := atomic.LoadInt32(&requests); got != failures+1 {
+		t.Errorf("server saw %d requests, want %d", got, failures+1)
+	}
+}
+
+func TestDroppedConnectionsExhaustRetries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err == nil {
+			conn.Close()
+		}
+	}))
+	defer server.Close()
+
+	client := NewA2AClient(&A2AClientConfig{BaseURL: server.URL, RetryPolicy: fastRetries(1)})
+	_, err := client.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowAgentList, nil))
+	if !HasCode(err, CodeConnectionFailed) {
+		t.Errorf("got %v, want CONNECTION_FAILED", err)
+	}
+}
+
+func TestRetriesServerErrors(t *testing.T) {
+	for _, status := range []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout} {
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&requests, 1) == 1 {
+				http.Error(w, "upstream unavailable", status)
+				return
+			}
+			io.WriteString(w, okResponse)
+		}))
+
+		// SERVER_ERROR is only retried when the policy opts in
+		policy := fastRetries(1)
+		policy.RetryableErrors = append(policy.RetryableErrors, CodeServerError)
+		client := NewA2AClient(&A2AClientConfig{BaseURL: server.URL, RetryPolicy: policy})
+		if _, err := client.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowAgentList, nil)); err != nil {
+			t.Errorf("status %d: SendMessage: %v", status, err)
+		}
+		server.Close()
+	}
+}
+
+func TestServerErrorsNotRetriedByDefault(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		http.Error(w, "upstream unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := NewA2AClient(&A2AClientConfig{BaseURL: server.URL, RetryPolicy: fastRetries(2)})
+	_, err := client.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowAgentList, nil))
+	if !HasCode(err, CodeServerError) || IsRetryable(err) {
+		t.Fatalf("got %v, want a non-retryable SERVER_ERROR", err)
+	}
+	if requests != 1 {
+		t.Errorf("server saw %d requests, want 1", requests)
+	}
+}
+
+func TestServerErrorIsCoded(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "upstream unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := NewA2AClient(&A2AClientConfig{BaseURL: server.URL, RetryPolicy: fastRetries(0)})
+	_, err := client.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowAgentList, nil))
+	var clientErr *A2AClientError
+	if !errors.As(err, &clientErr) || clientErr.Code != CodeServerError {
+		t.Fatalf("got %v, want SERVER_ERROR", err)
+	}
+	if clientErr.Details != http.StatusServiceUnavailable {
+		t.Errorf("got details %v, want the status code", clientErr.Details)
+	}
+}
+
+func TestServerErrorFailsOver(t *testing.T) {
+	var primaryRequests, secondaryRequests int32
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&primaryRequests, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer primary.Close()
+	secondary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&secondaryRequests, 1)
+		io.WriteString(w, okResponse)
+	}))
+	defer secondary.Close()
+
+	policy := fastRetries(1)
+	policy.RetryableErrors = append(policy.RetryableErrors, CodeServerError)
+	client := NewA2AClient(&A2AClientConfig{
+		BaseURLs:         []string{primary.URL, secondary.URL},
+		EndpointStrategy: EndpointPrimaryWithFailover,
+		RetryPolicy:      policy,
+	})
+	if _, err := client.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowAgentList, nil)); err != nil {
+		t.Fatalf("SendMessage: %v", err)
+	}
+	if primaryRequests != 1 || secondaryRequests != 1 {
+		t.Errorf("primary saw %d requests and secondary %d, want 1 each", primaryRequests, secondaryRequests)
+	}
+}
+
+func TestCustomBackoffAttempts(t *testing.T) {
+	var attempts []int
+	policy := fastRetries(3)
+	policy.BackoffStrategy = "custom"
+	policy.CustomBackoff = func(attempt int, baseDelay, maxDelay time.Duration) time.Duration {
+		attempts = append(attempts, attempt)
+		return 0
+	}
+	client := memoryClient(func(context.Context, *A2AMessage) (*A2AResponse, error) {
+		return nil, NewA2AClientError(CodeConnectionFailed, "refused", nil)
+	}, func(config *A2AClientConfig) {
+		config.RetryPolicy = policy
+	})
+
+	if _, err := client.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowAgentSpawn, nil)); err == nil {
+		t.Fatal("SendMessage succeeded")
+	}
+	if want := []int{0, 1, 2}; !reflect.DeepEqual(attempts, want) {
+		t.Errorf("CustomBackoff called with attempts %v, want %v", attempts, want)
+	}
+}
+
+func TestCustomBackoffClamped(t *testing.T) {
+	tests := []struct {
+		name   string
+		custom time.Duration
+		max    time.Duration
+		want   time.Duration
+	}{
+		{"within MaxDelay", 3 * time.Millisecond, 5 * time.Millisecond, 3 * time.Millisecond},
+		{"above MaxDelay", time.Hour, 5 * time.Millisecond, 5 * time.Millisecond},
+		{"no MaxDelay", time.Hour, 0, time.Hour},
+		{"negative", -time.Second, 5 * time.Millisecond, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := &RetryPolicy{
+				BackoffStrategy: "custom",
+				BaseDelay:       time.Millisecond,
+				MaxDelay:        tt.max,
+				CustomBackoff: func(int, time.Duration, time.Duration) time.Duration {
+					return tt.custom
+				},
+			}
+			if got := backoffDelay(policy, 0); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		header string
+		want   time.Duration
+		ok     bool
+	}{
+		{"5", 5 * time.Second, true},
+		{" 120 ", 2 * time.Minute, true},
+		{"0", 0, true},
+		{"-1", 0, false},
+		{"soon", 0, false},
+		{"", 0, false},
+		{time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), 0, true},
+	}
+	for _, tt := range tests {
+		got, ok := parseRetryAfter(tt.header)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("parseRetryAfter(%q) = %v, %v; want %v, %v", tt.header, got, ok, tt.want, tt.ok)
+		}
+	}
+
+	// HTTP dates have whole-second precision
+	date := time.Now().Add(30 * time.Second).UTC().Format(http.TimeFormat)
+	got, ok := parseRetryAfter(date)
+	if !ok || got <= 28*time.Second || got > 30*time.Second {
+		t.Errorf("parseRetryAfter(%q) = %v, %v; want about 30s", date, got, ok)
+	}
+}
+
+func TestRetryAfterCappedByMaxDelay(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		io.WriteString(w, okResponse)
+	}))
+	defer server.Close()
+
+	policy := fastRetries(1)
+	policy.MaxDelay = 10 * time.Millisecond
+	client := NewA2AClient(&A2AClientConfig{BaseURL: server.URL, RetryPolicy: policy})
+
+	start := time.Now()
+	if _, err := client.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowAgentList, nil)); err != nil {
+		t.Fatalf("SendMessage: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("retry waited %v; Retry-After was not capped by MaxDelay", elapsed)
+	}
+	if requests != 2 {
+		t.Errorf("server saw %d requests, want 2", requests)
+	}
+}
+
+func TestTotalTimeoutStopsRetries(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		time.Sleep(40 * time.Millisecond)
+		http.Error(w, "upstream unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	policy := fastRetries(10)
+	policy.RetryableErrors = append(policy.RetryableErrors, CodeServerError)
+	policy.TotalTimeout = 100 * time.Millisecond
+	client := NewA2AClient(&A2AClientConfig{BaseURL: server.URL, RetryPolicy: policy})
+
+	start := time.Now()
+	_, err := client.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowAgentList, nil))
+	if !HasCode(err, CodeRetryBudgetExceeded) {
+		t.Fatalf("got %v, want RETRY_BUDGET_EXCEEDED", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("gave up after %v, want about the 100ms budget", elapsed)
+	}
+	if got := atomic.LoadInt32(&requests); got > 4 {
+		t.Errorf("server saw %d requests, want the budget to stop retries well before MaxRetries", got)
+	}
+}
+
+func TestJitterBounds(t *testing.T) {
+	tests := []struct {
+		jitter   string
+		minShare float64 // lowest fraction of the backoff delay waited
+	}{
+		{"none", 1},
+		{"full", 0},
+		{"equal", 0.5},
+	}
+	for _, tt := range tests {
+		policy := &RetryPolicy{BackoffStrategy: "exponential", BaseDelay: 10 * time.Millisecond, MaxDelay: time.Second, Jitter: tt.jitter}
+		for attempt := 0; attempt < 8; attempt++ {
+			base := backoffDelay(policy, attempt)
+			low := time.Duration(tt.minShare * float64(base))
+			for i := 0; i < 200; i++ {
+				if delay := jitterDelay(policy, base); delay < low || delay > base {
+					t.Fatalf("%s jitter of %v gave %v, want within [%v, %v]", tt.jitter, base, delay, low, base)
+				}
+			}
+		}
+
+		// The extremes of the random source reach the ends of the range
+		policy.JitterSource = func() float64 { return 0 }
+		if delay := jitterDelay(policy, time.Second); delay != time.Duration(tt.minShare*float64(time.Second)) {
+			t.Errorf("%s jitter with source 0 gave %v", tt.jitter, delay)
+		}
+		policy.JitterSource = func() float64 { return math.Nextafter(1, 0) }
+		if delay := jitterDelay(policy, time.Second); time.Second-delay > time.Microsecond {
+			t.Errorf("%s jitter with source near 1 gave %v, want about 1s", tt.jitter, delay)
+		}
+	}
+}
+
+func TestIdempotencyKeyStableAcrossRetries(t *testing.T) {
+	const failures = 2
+	var mu sync.Mutex
+	var keys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		attempt := len(keys)
+		mu.Unlock()
+		if attempt <= failures {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		io.WriteString(w, okResponse)
+	}))
+	defer server.Close()
+
+	client := NewA2AClient(&A2AClientConfig{BaseURL: server.URL, RetryPolicy: fastRetries(failures)})
+	message := directMessage(MCPToolClaudeFlowAgentSpawn, nil)
+	if _, err := client.SendMessage(context.Background(), message); err != nil {
+		t.Fatalf("SendMessage: %v", err)
+	}
+
+	if len(keys) != failures+1 {
+		t.Fatalf("server saw %d attempts, want %d", len(keys), failures+1)
+	}
+	for i, key := range keys {
+		if key == "" || key != message.IdempotencyKey {
+			t.Errorf("attempt %d sent Idempotency-Key %q, want %q", i+1, key, message.IdempotencyKey)
+		}
+	}
+}
+
+func TestDeadLetterOnRetryExhaustion(t *testing.T) {
+	type deadLetter struct {
+		message *A2AMessage
+		err     error
+	}
+	var letters []deadLetter
+	var sends int32
+	client := memoryClient(func(context.Context, *A2AMessage) (*A2AResponse, error) {
+		attempt := atomic.AddInt32(&sends, 1)
+		return nil, NewA2AClientError(CodeConnectionFailed, "refused", attempt)
+	}, func(config *A2AClientConfig) {
+		config.RetryPolicy = fastRetries(2)
+		config.OnDeadLetter = func(message *A2AMessage, lastErr error) {
+			letters = append(letters, deadLetter{message, lastErr})
+		}
+	})
+
+	message := directMessage(MCPToolClaudeFlowAgentSpawn, nil)
+	_, err := client.SendMessage(context.Background(), message)
+	if !HasCode(err, CodeConnectionFailed) {
+		t.Fatalf("got %v, want CONNECTION_FAILED", err)
+	}
+
+	if len(letters) != 1 {
+		t.Fatalf("OnDeadLetter called %d times, want once", len(letters))
+	}
+	var lastErr *A2AClientError
+	if letters[0].message != message || !errors.As(letters[0].err, &lastErr) || lastErr.Details != int32(3) {
+		t.Errorf("OnDeadLetter got %v for %v, want the error of the third attempt for %s", letters[0].err, letters[0].message, message.ID)
+	}
+}
+
+func TestPerMessageRetryPolicies(t *testing.T) {
+	var mu sync.Mutex
+	sends := map[MCPToolName]int{}
+	client := memoryClient(func(_ context.Context, message *A2AMessage) (*A2AResponse, error) {
+		mu.Lock()
+		sends[message.ToolName]++
+		mu.Unlock()
+		return nil, NewA2AClientError(CodeConnectionFailed, "refused", nil)
+	}, func(config *A2AClientConfig) {
+		config.RetryPolicy = fastRetries(0)
+	})
+
+	policies := map[MCPToolName]int{
+		MCPToolClaudeFlowAgentSpawn: 1,
+		MCPToolClaudeFlowSwarmInit:  3,
+	}
+	for tool, maxRetries := range policies {
+		message := directMessage(tool, nil)
+		message.RetryPolicy = &RetryPolicy{MaxRetries: maxRetries, BackoffStrategy: "linear", BaseDelay: time.Millisecond}
+		if _, err := client.SendMessage(context.Background(), message); !HasCode(err, CodeConnectionFailed) {
+			t.Fatalf("%s: got %v, want CONNECTION_FAILED", tool, err)
+		}
+	}
+
+	for tool, maxRetries := range policies {
+		if sends[tool] != maxRetries+1 {
+			t.Errorf("%s sent %d times, want %d", tool, sends[tool], maxRetries+1)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package a2aclient
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ScheduleConfig describes a scheduled tool invocation
+type ScheduleConfig struct {
+	ID         string // required for update and delete
+	Cron       string // standard five-field expression or a descriptor such as "@daily"
+	ToolName   MCPToolName
+	Parameters map[string]interface{}
+}
+
+// cronFields gives the name and bounds of each field of a cron expression
+var cronFields = []struct {
+	name     string
+	min, max int
+	names    []string // symbolic values, starting at min
+}{
+	{"minute", 0, 59, nil},
+	{"hour", 0, 23, nil},
+	{"day of month", 1, 31, nil},
+	{"month", 1, 12, []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}},
+	{"day of week", 0, 7, []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}},
+}
+
+// cronDescriptors are the accepted shorthand expressions
+var cronDescriptors = map[string]bool{
+	"@yearly": true, "@annually": true, "@monthly": true, "@weekly": true, "@daily": true, "@midnight": true, "@hourly": true,
+}
+
+// ManageSchedule creates, updates, deletes or lists scheduled tool
+// invocations. The cron expression is checked before sending; it is
+// required to create a schedule and optional when updating one.
+func (c *A2AClient) ManageSchedule(ctx context.Context, action string, config ScheduleConfig) (*A2AResponse, error) {
+	params := map[string]interface{}{"action": action}
+
+	switch action {
+	case "create", "update":
+		if action == "create" && config.Cron == "" {
+			return nil, NewA2AClientError(CodeValidation, "Creating a schedule requires a cron expression", nil)
+		}
+		if action == "create" && config.ToolName == "" {
+			return nil, NewA2AClientError(CodeValidation, "Creating a schedule requires a target tool", nil)
+		}
+		if action == "update" && config.ID == "" {
+			return nil, NewA2AClientError(CodeValidation, "Updating a schedule requires its ID", nil)
+		}
+		if config.Cron != "" {
+			if err := validateCron(config.Cron); err != nil {
+				return nil, NewA2AClientError(CodeValidation, fmt.Sprintf("Invalid cron expression %q: %v", config.Cron, err), config.Cron)
+			}
+			params["cron"] = config.Cron
+		}
+		if config.ToolName != "" {
+			params["toolName"] = config.ToolName
+		}
+		if config.Parameters != nil {
+			params["parameters"] = config.Parameters
+		}
+	case "delete":
+		if config.ID == "" {
+			return nil, NewA2AClientError(CodeValidation, "Deleting a schedule requires its ID", nil)
+		}
+	case "list":
+	default:
+		return nil, NewA2AClientError(CodeValidation, fmt.Sprintf("Unknown schedule action %q", action), action)
+	}
+	if config.ID != "" {
+		params["scheduleId"] = config.ID
+	}
+
+	message := &A2AMessage{
+		Target: AgentTarget{
+			GroupTarget: &GroupTarget{
+				Type:              "group",
+				Role:              AgentRoleTaskOrchestrator,
+				MaxAgents:         intPtr(1),
+				SelectionStrategy: "load-balanced",
+			},
+		},
+		ToolName:   MCPToolClaudeFlowSchedulerManage,
+		Parameters: params,
+		Coordination: CoordinationMode{
+			DirectCoordination: &DirectCoordination{
+				Mode: "direct",
+			},
+		},
+	}
+
+	return c.SendMessage(ctx, message)
+}
+
+// validateCron checks a five-field cron expression (minute, hour, day of
+// month, month, day of week). Fields accept "*", values, ranges ("1-5"),
+// steps ("*/15", "0-30/10"), comma-separated lists, and month and weekday
+// names.
+func validateCron(expr string) error {
+	expr = strings.TrimSpace(expr)
+	if strings.HasPrefix(expr, "@") {
+		if !cronDescriptors[strings.ToLower(expr)] {
+			return fmt.Errorf("unknown descriptor %s", expr)
+		}
+		return nil
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != len(cronFields) {
+		return fmt.Errorf("expected %d fields, got %d", len(cronFields), len(fields))
+	}
+	for i, field := range fields {
+		spec := cronFields[i]
+		for _, part := range strings.Split(field, ",") {
+			if err := validateCronPart(part, spec.min, spec.max, spec.names); err != nil {
+				return fmt.Errorf("%s: %w", spec.name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateCronPart checks one comma-separated element of a cron field
+func validateCronPart(part string, min, max int, names []string) error {
+	rangePart, step, hasStep := strings.Cut(part, "/")
+	if hasStep {
+		n, err := strconv.Atoi(step)
+		if err != nil || n <= 0 {
+			return fmt.Errorf("invalid step %q", step)
+		}
+	}
+	if rangePart == "*" {
+		return nil
+	}
+
+	low, high, isRange := strings.Cut(rangePart, "-")
+	from, err := cronValue(low, min, max, names)
+	if err != nil {
+		return err
+	}
+	if !isRange {
+		return nil
+	}
+	to, err := cronValue(high, min, max, names)
+	if err != nil {
+		return err
+	}
+	if from > to {
+		return fmt.Errorf("range %s is backwards", rangePart)
+	}
+	return nil
+}
+
+// cronValue parses a single cron value, numeric or named, within bounds
+func cronValue(value string, min, max int, names []string) (int, error) {
+	for i, name := range names {
+		if strings.EqualFold(value, name) {
+			return min + i, nil
+		}
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", value)
+	}
+	if n < min || n > max {
+		return 0, fmt.Errorf("value %d out of range %d-%d", n, min, max)
+	}
+	return n, nil
+}
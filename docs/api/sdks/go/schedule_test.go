@@ -0,0 +1,78 @@
+package a2aclient
+
+import (
+	"context"
+	"testing"
+)
+
+func TestValidateCron(t *testing.T) {
+	tests := []struct {
+		expr  string
+		valid bool
+	}{
+		{"* * * * *", true},
+		{"*/15 0-6 1,15 * mon-fri", true},
+		{"0 9 * JAN,jul 0", true},
+		{"0-30/10 * * * 7", true},
+		{"@daily", true},
+		{"@HOURLY", true},
+		{"@fortnightly", false},
+		{"* * * *", false},
+		{"60 * * * *", false},
+		{"* 24 * * *", false},
+		{"* * 0 * *", false},
+		{"* * * 13 *", false},
+		{"*/0 * * * *", false},
+		{"5-1 * * * *", false},
+		{"* * * foo *", false},
+	}
+	for _, tt := range tests {
+		if err := validateCron(tt.expr); (err == nil) != tt.valid {
+			t.Errorf("validateCron(%q) = %v, want valid %v", tt.expr, err, tt.valid)
+		}
+	}
+}
+
+func TestManageSchedule(t *testing.T) {
+	var sent *A2AMessage
+	client := memoryClient(func(_ context.Context, message *A2AMessage) (*A2AResponse, error) {
+		sent = message
+		return echoResult(message), nil
+	}, nil)
+	ctx := context.Background()
+
+	invalid := []struct {
+		action string
+		config ScheduleConfig
+	}{
+		{"pause", ScheduleConfig{ID: "s-1"}},
+		{"create", ScheduleConfig{ToolName: MCPToolClaudeFlowSwarmStatus}},
+		{"create", ScheduleConfig{Cron: "@daily"}},
+		{"create", ScheduleConfig{Cron: "* * *", ToolName: MCPToolClaudeFlowSwarmStatus}},
+		{"update", ScheduleConfig{Cron: "@daily"}},
+		{"delete", ScheduleConfig{}},
+	}
+	for _, tt := range invalid {
+		if _, err := client.ManageSchedule(ctx, tt.action, tt.config); !HasCode(err, CodeValidation) {
+			t.Errorf("%s %+v: got %v, want VALIDATION_ERROR", tt.action, tt.config, err)
+		}
+	}
+	if sent != nil {
+		t.Fatal("an invalid schedule request was sent")
+	}
+
+	_, err := client.ManageSchedule(ctx, "update", ScheduleConfig{ID: "s-1", Cron: "0 * * * *"})
+	if err != nil {
+		t.Fatalf("ManageSchedule: %v", err)
+	}
+	if sent.ToolName != MCPToolClaudeFlowSchedulerManage || sent.Parameters["scheduleId"] != "s-1" || sent.Parameters["cron"] != "0 * * * *" {
+		t.Errorf("sent %s %v", sent.ToolName, sent.Parameters)
+	}
+	if _, ok := sent.Parameters["toolName"]; ok {
+		t.Errorf("params %v, want fields not being updated left out", sent.Parameters)
+	}
+
+	if _, err := client.ManageSchedule(ctx, "list", ScheduleConfig{}); err != nil || sent.Parameters["action"] != "list" {
+		t.Errorf("list: params %v, err %v", sent.Parameters, err)
+	}
+}
@@ -0,0 +1,91 @@
+package a2aclient
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// severityRank orders finding severities from least to most severe
+var severityRank = map[string]int{"low": 0, "medium": 1, "high": 2, "critical": 3}
+
+// SecurityReport is the decoded result of a security scan
+type SecurityReport struct {
+	Target   string            `json:"target"`
+	Findings []SecurityFinding `json:"findings"`
+}
+
+// SecurityFinding is a single issue reported by a security scan
+type SecurityFinding struct {
+	ID          string `json:"id,omitempty"`
+	Severity    string `json:"severity"` // "low", "medium", "high", "critical"
+	Category    string `json:"category"`
+	Title       string `json:"title,omitempty"`
+	Location    string `json:"location"`
+	Remediation string `json:"remediation,omitempty"`
+}
+
+// SecurityScan scans target, such as a repository path or service, on a
+// security-manager agent and returns the findings at or above minSeverity
+// ("low", "medium", "high" or "critical"; empty means "low"). Findings below
+// it are dropped even if the server returns them.
+func (c *A2AClient) SecurityScan(ctx context.Context, target string, minSeverity string) (*SecurityReport, error) {
+	if target == "" {
+		return nil, NewA2AClientError(CodeValidation, "Security scan target is required", nil)
+	}
+	if minSeverity == "" {
+		minSeverity = "low"
+	}
+	minSeverity = strings.ToLower(minSeverity)
+	minRank, ok := severityRank[minSeverity]
+	if !ok {
+		return nil, NewA2AClientError(CodeValidation, fmt.Sprintf("Unknown severity %q", minSeverity), minSeverity)
+	}
+
+	message := &A2AMessage{
+		Target: AgentTarget{
+			GroupTarget: &GroupTarget{
+				Type:              "group",
+				Role:              AgentRoleSecurityManager,
+				MaxAgents:         intPtr(1),
+				SelectionStrategy: "load-balanced",
+			},
+		},
+		ToolName: MCPToolClaudeFlowSecurityScan,
+		Parameters: map[string]interface{}{
+			"target":      target,
+			"minSeverity": minSeverity,
+		},
+		Coordination: CoordinationMode{
+			DirectCoordination: &DirectCoordination{
+				Mode: "direct",
+			},
+		},
+	}
+
+	response, err := c.SendMessage(ctx, message)
+	if err != nil {
+		return nil, err
+	}
+	if err := responseError(response); err != nil {
+		return nil, err
+	}
+
+	var report SecurityReport
+	if err := decodeMap(response.Result, &report); err != nil {
+		return nil, NewA2AClientError(CodeDecode, fmt.Sprintf("Failed to decode security report: %v", err), response.Result)
+	}
+	if report.Target == "" {
+		report.Target = target
+	}
+
+	findings := report.Findings[:0]
+	for _, finding := range report.Findings {
+		// Unknown severities rank as low
+		if severityRank[strings.ToLower(finding.Severity)] >= minRank {
+			findings = append(findings, finding)
+		}
+	}
+	report.Findings = findings
+	return &report, nil
+}
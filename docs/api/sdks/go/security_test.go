@@ -0,0 +1,58 @@
+package a2aclient
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestSecurityScanFiltersBySeverity(t *testing.T) {
+	var sent *A2AMessage
+	client := memoryClient(func(_ context.Context, message *A2AMessage) (*A2AResponse, error) {
+		sent = message
+		// The server ignores minSeverity and returns everything
+		return &A2AResponse{Success: true, Result: map[string]interface{}{"findings": []interface{}{
+			map[string]interface{}{"id": "f1", "severity": "low"},
+			map[string]interface{}{"id": "f2", "severity": "HIGH"},
+			map[string]interface{}{"id": "f3", "severity": "medium"},
+			map[string]interface{}{"id": "f4", "severity": "critical"},
+			map[string]interface{}{"id": "f5", "severity": "unknown"},
+		}}}, nil
+	}, nil)
+	ctx := context.Background()
+
+	if _, err := client.SecurityScan(ctx, "", ""); !HasCode(err, CodeValidation) {
+		t.Errorf("no target: got %v, want VALIDATION_ERROR", err)
+	}
+	if _, err := client.SecurityScan(ctx, "repo", "severe"); !HasCode(err, CodeValidation) {
+		t.Errorf("unknown severity: got %v, want VALIDATION_ERROR", err)
+	}
+
+	tests := []struct {
+		minSeverity string
+		want        []string
+	}{
+		{"", []string{"f1", "f2", "f3", "f4", "f5"}},
+		{"Medium", []string{"f2", "f3", "f4"}},
+		{"critical", []string{"f4"}},
+	}
+	for _, tt := range tests {
+		report, err := client.SecurityScan(ctx, "repo", tt.minSeverity)
+		if err != nil {
+			t.Fatalf("SecurityScan(%q): %v", tt.minSeverity, err)
+		}
+		var ids []string
+		for _, finding := range report.Findings {
+			ids = append(ids, finding.ID)
+		}
+		if !reflect.DeepEqual(ids, tt.want) {
+			t.Errorf("minimum %q: findings %v, want %v", tt.minSeverity, ids, tt.want)
+		}
+		if report.Target != "repo" {
+			t.Errorf("target %q, want it filled in from the request", report.Target)
+		}
+	}
+	if sent.Parameters["minSeverity"] != "critical" || sent.Target.GroupTarget.Role != AgentRoleSecurityManager {
+		t.Errorf("sent %v to %+v", sent.Parameters, sent.Target.GroupTarget)
+	}
+}
@@ -0,0 +1,101 @@
+package a2aclient
+
+import (
+	"context"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// errClientShutdown fails sends started after Shutdown and those still
+// running when its deadline passes
+var errClientShutdown = NewA2AClientError(CodeClientShutdown, "Client is shut down", nil)
+
+// closeFrameTimeout bounds writing the close frame to each connection
+const closeFrameTimeout = time.Second
+
+// Shutdown stops the client gracefully. New sends fail with CLIENT_SHUTDOWN
+// straight away while sends already in flight are given until ctx is done to
+// finish; any still running then fail with CLIENT_SHUTDOWN too. The
+// WebSocket connections are closed with a close frame afterwards. It returns
+// ctx's error if in-flight sends had to be cut short. A client that has been
+// shut down cannot be reused; Disconnect is the abrupt, reusable
+// alternative.
+func (c *A2AClient) Shutdown(ctx context.Context) error {
+	c.lifecycleMutex.Lock()
+	c.shuttingDown = true
+	idle := make(chan struct{})
+	if c.inFlight == 0 {
+		close(idle)
+	} else {
+		c.idle = idle
+	}
+	c.lifecycleMutex.Unlock()
+
+	var err error
+	select {
+	case <-idle:
+	case <-ctx.Done():
+		err = ctx.Err()
+		c.forceShutdown()
+	}
+
+	c.connectionMux.RLock()
+	links := append([]*wsLink(nil), c.wsLinks...)
+	c.connectionMux.RUnlock()
+	closeFrame := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "client shutdown")
+	for _, link := range links {
+		if link == nil {
+			continue
+		}
+		link.writeMutex.Lock()
+		link.conn.WriteControl(websocket.CloseMessage, closeFrame, time.Now().Add(closeFrameTimeout))
+		link.writeMutex.Unlock()
+	}
+
+	c.Disconnect()
+	return err
+}
+
+// beginRequest registers a send with the shutdown tracker. The returned
+// context is cancelled with CLIENT_SHUTDOWN as its cause if Shutdown gives up
+// waiting, and end must be called once the send is over.
+func (c *A2AClient) beginRequest(ctx context.Context) (context.Context, func(), error) {
+	c.lifecycleMutex.Lock()
+	if c.shuttingDown {
+		c.lifecycleMutex.Unlock()
+		return nil, nil, errClientShutdown
+	}
+	c.inFlight++
+	c.lifecycleMutex.Unlock()
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	stop := context.AfterFunc(c.shutdownCtx, func() {
+		cancel(errClientShutdown)
+	})
+
+	end := func() {
+		stop()
+		cancel(nil)
+
+		c.lifecycleMutex.Lock()
+		c.inFlight--
+		if c.inFlight == 0 && c.idle != nil {
+			close(c.idle)
+			c.idle = nil
+		}
+		c.lifecycleMutex.Unlock()
+	}
+	return ctx, end, nil
+}
+
+// shutdownError returns CLIENT_SHUTDOWN in place of err if the send was cut
+// short by Shutdown. The client's shutdown context is checked rather than
+// the send's, whose cancellation runs asynchronously and may land after
+// Disconnect has already failed the send with CONNECTION_LOST.
+func (c *A2AClient) shutdownError(err error) error {
+	if err != nil && c.shutdownCtx.Err() != nil {
+		return errClientShutdown
+	}
+	return err
+}
@@ -0,0 +1,49 @@
+package a2aclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestShutdownFailsPendingRequests(t *testing.T) {
+	arrived := make(chan struct{}, 1)
+	server := newWSServer(t, func(_ *websocket.Conn, message *A2AMessage) *A2AResponse {
+		// Never answered
+		arrived <- struct{}{}
+		return nil
+	})
+	client := connectWS(t, server, func(config *A2AClientConfig) {
+		config.RetryPolicy = fastRetries(0)
+		config.Timeout = time.Minute
+	})
+
+	errs := make(chan error, 1)
+	go func() {
+		_, err := client.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowSwarmStatus, nil))
+		errs <- err
+	}()
+	<-arrived
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := client.Shutdown(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Shutdown returned %v, want context.DeadlineExceeded", err)
+	}
+
+	select {
+	case err := <-errs:
+		if !HasCode(err, CodeClientShutdown) {
+			t.Errorf("pending request got %v, want CLIENT_SHUTDOWN", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("pending request still running after Shutdown")
+	}
+
+	if _, err := client.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowSwarmStatus, nil)); !HasCode(err, CodeClientShutdown) {
+		t.Errorf("send after Shutdown got %v, want CLIENT_SHUTDOWN", err)
+	}
+}
@@ -0,0 +1,168 @@
+package a2aclient
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+)
+
+// WorkloadEstimate describes a workload to size a swarm for
+type WorkloadEstimate struct {
+	WorkUnits          int           // independent units of work that can run in parallel
+	SequentialFraction float64       // share of the work (0-1) that cannot be parallelized
+	TargetLatency      time.Duration // desired wall-clock time for the whole workload
+	PerAgentThroughput float64       // work units per second per agent; 0 derives it from performance_report
+	MaxAgents          int           // optional upper bound on the recommendation
+}
+
+// AgentCountRecommendation is a recommended agent count with the inputs and
+// reasoning that produced it
+type AgentCountRecommendation struct {
+	Count              int
+	PerAgentThroughput float64
+	EstimatedLatency   time.Duration
+	Reasoning          []string
+}
+
+// performanceSnapshot is the subset of performance_report used for sizing
+type performanceSnapshot struct {
+	Throughput      float64 `json:"throughput"`        // work units per second across all agents
+	AvgTaskDuration float64 `json:"avg_task_duration"` // milliseconds per work unit
+	ActiveAgents    int     `json:"active_agents"`
+}
+
+// usageSnapshot is the subset of usage_stats used for sizing
+type usageSnapshot struct {
+	ActiveAgents int `json:"active_agents"`
+	AgentLimit   int `json:"agent_limit"`
+}
+
+// RecommendAgentCount recommends a MaxAgents value for SwarmConfig or
+// TaskOrchestrationConfig. Use ExplainAgentCount to also get the reasoning.
+func (c *A2AClient) RecommendAgentCount(ctx context.Context, workload WorkloadEstimate) (int, error) {
+	recommendation, err := c.ExplainAgentCount(ctx, workload)
+	if err != nil {
+		return 0, err
+	}
+	return recommendation.Count, nil
+}
+
+// ExplainAgentCount recommends an agent count for the workload using observed
+// per-agent throughput and Amdahl's law, capped by the number of work units,
+// the server's agent limit, and the workload's MaxAgents
+func (c *A2AClient) ExplainAgentCount(ctx context.Context, workload WorkloadEstimate) (*AgentCountRecommendation, error) {
+	if workload.WorkUnits <= 0 {
+		return nil, NewA2AClientError(CodeValidation, "Workload must have at least one work unit", workload.WorkUnits)
+	}
+	if workload.TargetLatency <= 0 {
+		return nil, NewA2AClientError(CodeValidation, "Workload target latency must be positive", workload.TargetLatency)
+	}
+	if workload.SequentialFraction < 0 || workload.SequentialFraction >= 1 {
+		return nil, NewA2AClientError(CodeValidation, "Workload sequential fraction must be in [0, 1)", workload.SequentialFraction)
+	}
+
+	recommendation := &AgentCountRecommendation{PerAgentThroughput: workload.PerAgentThroughput}
+
+	if recommendation.PerAgentThroughput <= 0 {
+		var perf performanceSnapshot
+		if err := c.queryAnalytics(ctx, MCPToolClaudeFlowPerformanceReport, map[string]interface{}{"format": "json", "timeframe": "24h"}, &perf); err != nil {
+			return nil, err
+		}
+		switch {
+		case perf.AvgTaskDuration > 0:
+			recommendation.PerAgentThroughput = 1000 / perf.AvgTaskDuration
+			recommendation.Reasoning = append(recommendation.Reasoning,
+				fmt.Sprintf("observed average task duration of %.0fms gives %.2f units/s per agent", perf.AvgTaskDuration, recommendation.PerAgentThroughput))
+		case perf.Throughput > 0 && perf.ActiveAgents > 0:
+			recommendation.PerAgentThroughput = perf.Throughput / float64(perf.ActiveAgents)
+			recommendation.Reasoning = append(recommendation.Reasoning,
+				fmt.Sprintf("observed throughput of %.2f units/s over %d agents gives %.2f units/s per agent", perf.Throughput, perf.ActiveAgents, recommendation.PerAgentThroughput))
+		default:
+			return nil, NewA2AClientError(CodeInsufficientData, "Performance report has no throughput data; set PerAgentThroughput", nil)
+		}
+	} else {
+		recommendation.Reasoning = append(recommendation.Reasoning,
+			fmt.Sprintf("using supplied throughput of %.2f units/s per agent", recommendation.PerAgentThroughput))
+	}
+
+	units := float64(workload.WorkUnits)
+	throughput := recommendation.PerAgentThroughput
+	sequentialTime := units * workload.SequentialFraction / throughput
+	parallelTime := units * (1 - workload.SequentialFraction) / throughput
+	target := workload.TargetLatency.Seconds()
+
+	var count int
+	if sequentialTime >= target {
+		count = workload.WorkUnits
+		recommendation.Reasoning = append(recommendation.Reasoning,
+			fmt.Sprintf("sequential work alone takes %.1fs, exceeding the %s target; using one agent per work unit", sequentialTime, workload.TargetLatency))
+	} else {
+		count = int(math.Ceil(parallelTime / (target - sequentialTime)))
+		recommendation.Reasoning = append(recommendation.Reasoning,
+			fmt.Sprintf("%d agents finish %.1fs of parallel work within the %s target", count, parallelTime, workload.TargetLatency))
+	}
+
+	if count > workload.WorkUnits {
+		count = workload.WorkUnits
+		recommendation.Reasoning = append(recommendation.Reasoning,
+			fmt.Sprintf("capped at %d agents, one per work unit", count))
+	}
+
+	var usage usageSnapshot
+	if err := c.queryAnalytics(ctx, MCPToolClaudeFlowUsageStats, map[string]interface{}{"format": "json"}, &usage); err != nil {
+		return nil, err
+	}
+	if usage.AgentLimit > 0 && count > usage.AgentLimit {
+		count = usage.AgentLimit
+		recommendation.Reasoning = append(recommendation.Reasoning,
+			fmt.Sprintf("capped at the server agent limit of %d", count))
+	}
+
+	if workload.MaxAgents > 0 && count > workload.MaxAgents {
+		count = workload.MaxAgents
+		recommendation.Reasoning = append(recommendation.Reasoning,
+			fmt.Sprintf("capped at the requested maximum of %d", count))
+	}
+
+	if count < 1 {
+		count = 1
+	}
+
+	recommendation.Count = count
+	recommendation.EstimatedLatency = time.Duration((sequentialTime + parallelTime/float64(count)) * float64(time.Second))
+	return recommendation, nil
+}
+
+// queryAnalytics sends a direct request to a performance monitor and decodes
+// its result into dest
+func (c *A2AClient) queryAnalytics(ctx context.Context, toolName MCPToolName, params map[string]interface{}, dest interface{}) error {
+	message := &A2AMessage{
+		Target: AgentTarget{
+			GroupTarget: &GroupTarget{
+				Type:      "group",
+				Role:      AgentRolePerformanceMonitor,
+				MaxAgents: intPtr(1),
+			},
+		},
+		ToolName:   toolName,
+		Parameters: params,
+		Coordination: CoordinationMode{
+			DirectCoordination: &DirectCoordination{
+				Mode: "direct",
+			},
+		},
+	}
+
+	response, err := c.SendMessage(ctx, message)
+	if err != nil {
+		return err
+	}
+	if err := responseError(response); err != nil {
+		return err
+	}
+	if err := decodeMap(response.Result, dest); err != nil {
+		return fmt.Errorf("failed to decode %s result: %w", toolName, err)
+	}
+	return nil
+}
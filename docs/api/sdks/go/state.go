@@ -0,0 +1,86 @@
+package a2aclient
+
+import "sync"
+
+// ConnectionState is the client's connectivity as reported to OnStateChange
+type ConnectionState string
+
+// Connection states
+const (
+	ConnectionStateDisconnected ConnectionState = "disconnected"
+	ConnectionStateConnecting   ConnectionState = "connecting"
+	ConnectionStateConnected    ConnectionState = "connected"
+	// ConnectionStateReconnecting means the WebSocket dropped and the
+	// reconnect supervisor is re-dialing it
+	ConnectionStateReconnecting ConnectionState = "reconnecting"
+)
+
+// stateTracker holds the connection state and the changes not yet delivered
+// to OnStateChange
+type stateTracker struct {
+	mu         sync.Mutex
+	state      ConnectionState
+	pending    [][2]ConnectionState
+	delivering bool
+}
+
+// State returns the client's current connection state
+func (c *A2AClient) State() ConnectionState {
+	c.connState.mu.Lock()
+	defer c.connState.mu.Unlock()
+	return c.connState.state
+}
+
+// setState records a transition to state. It may be called with
+// connectionMux held, so the callback is not run here; the caller must call
+// deliverStateChanges once it has released its locks.
+func (c *A2AClient) setState(state ConnectionState) {
+	c.connState.mu.Lock()
+	defer c.connState.mu.Unlock()
+	c.connState.transition(state)
+}
+
+// setStateIf records a transition to state only if the current state is
+// from, checking and setting it under one lock
+func (c *A2AClient) setStateIf(from, state ConnectionState) {
+	c.connState.mu.Lock()
+	defer c.connState.mu.Unlock()
+	if c.connState.state == from {
+		c.connState.transition(state)
+	}
+}
+
+// transition moves to state, recording the change for delivery. The caller
+// must hold mu.
+func (t *stateTracker) transition(state ConnectionState) {
+	if t.state == state {
+		return
+	}
+	t.pending = append(t.pending, [2]ConnectionState{t.state, state})
+	t.state = state
+}
+
+// deliverStateChanges passes recorded transitions to OnStateChange in order.
+// Only one goroutine delivers at a time, so callbacks never run concurrently;
+// transitions recorded meanwhile, including by the callback itself, are
+// delivered by the goroutine already delivering.
+func (c *A2AClient) deliverStateChanges() {
+	tracker := &c.connState
+	tracker.mu.Lock()
+	if tracker.delivering {
+		tracker.mu.Unlock()
+		return
+	}
+	tracker.delivering = true
+	for len(tracker.pending) > 0 {
+		change := tracker.pending[0]
+		tracker.pending = tracker.pending[1:]
+		tracker.mu.Unlock()
+		if c.config.OnStateChange != nil {
+			c.config.OnStateChange(change[0], change[1])
+		}
+		tracker.mu.Lock()
+	}
+	tracker.delivering = false
+	tracker.mu.Unlock()
+}
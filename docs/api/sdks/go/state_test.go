@@ -0,0 +1,68 @@
+package a2aclient
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestConnectionStateSequence(t *testing.T) {
+	server := newWSServer(t, func(_ *websocket.Conn, message *A2AMessage) *A2AResponse {
+		return echoResult(message)
+	})
+	changes := make(chan [2]ConnectionState, 16)
+	client := connectWS(t, server, func(config *A2AClientConfig) {
+		config.ReconnectEnabled = true
+		config.ReconnectPolicy = &ReconnectPolicy{MaxAttempts: 5, BackoffStrategy: "linear", BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+		config.OnStateChange = func(from, to ConnectionState) {
+			changes <- [2]ConnectionState{from, to}
+		}
+	})
+	server.dropConnections()
+
+	want := [][2]ConnectionState{
+		{ConnectionStateDisconnected, ConnectionStateConnecting},
+		{ConnectionStateConnecting, ConnectionStateConnected},
+		{ConnectionStateConnected, ConnectionStateReconnecting},
+		{ConnectionStateReconnecting, ConnectionStateConnected},
+	}
+	for i, w := range want {
+		select {
+		case got := <-changes:
+			if got != w {
+				t.Fatalf("change %d: got %s -> %s, want %s -> %s", i, got[0], got[1], w[0], w[1])
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("change %d (%s -> %s) never reported", i, w[0], w[1])
+		}
+	}
+	if state := client.State(); state != ConnectionStateConnected {
+		t.Errorf("final state %s, want connected", state)
+	}
+}
+
+func TestSetStateIfIsAtomic(t *testing.T) {
+	client := NewA2AClient(&A2AClientConfig{BaseURL: "http://a2a.test"})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			client.setStateIf(ConnectionStateDisconnected, ConnectionStateConnecting)
+		}()
+		go func() {
+			defer wg.Done()
+			client.setStateIf(ConnectionStateDisconnected, ConnectionStateConnected)
+		}()
+	}
+	wg.Wait()
+
+	client.connState.mu.Lock()
+	defer client.connState.mu.Unlock()
+	if n := len(client.connState.pending); n != 1 {
+		t.Errorf("%d transitions recorded from disconnected, want 1: %v", n, client.connState.pending)
+	}
+}
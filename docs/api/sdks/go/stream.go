@@ -0,0 +1,148 @@
+package a2aclient
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// SendMessageStream sends a message to a tool that reports progress, such as
+// swarm_monitor, metrics_collect or task_orchestrate, and forwards every
+// response on the returned channel until the server marks one Final or ends
+// the stream. Over WebSocket the responses share the message's correlation
+// ID; over HTTP they arrive as Server-Sent Events from the streaming
+// endpoint. A custom Transport must implement StreamingTransport. Both
+// channels are closed when the stream ends; the error channel first receives
+// the reason if it ended with a failure, including ctx being done. Streams
+// are not retried.
+func (c *A2AClient) SendMessageStream(ctx context.Context, message *A2AMessage) (<-chan *A2AResponse, <-chan error, error) {
+	streamer, ok := c.transport.(StreamingTransport)
+	if !ok {
+		return nil, nil, NewA2AClientError(CodeStreamingUnsupported, "The configured transport does not support streaming", nil)
+	}
+
+	ctx, done, err := c.beginStream(ctx, message)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	errs := make(chan error, 1)
+	finish := func(err error) {
+		if err = done(err); err != nil {
+			errs <- err
+		}
+		close(errs)
+	}
+
+	responses, err := streamer.Stream(ctx, message, finish)
+	if err != nil {
+		return nil, nil, done(err)
+	}
+	return responses, errs, nil
+}
+
+// beginStream prepares a message for streaming and starts its span and
+// request, waiting for the rate limiter. done must be called exactly once
+// with the stream's terminal error, which it returns as the caller should
+// see it.
+func (c *A2AClient) beginStream(ctx context.Context, message *A2AMessage) (context.Context, func(error) error, error) {
+	c.prepareMessage(message)
+	ctx, endSpan := c.startSpan(ctx, message)
+	ctx, end, err := c.beginRequest(ctx)
+	if err != nil {
+		endSpan(nil, err)
+		return nil, nil, err
+	}
+	done := func(err error) error {
+		err = c.shutdownError(err)
+		end()
+		endSpan(nil, err)
+		return err
+	}
+	if err := c.waitRateLimit(ctx); err != nil {
+		return nil, nil, done(err)
+	}
+	c.logRequest(message)
+	return ctx, done, nil
+}
+
+// openEventStream posts a message to the HTTP streaming endpoint and
+// forwards every response sent as a Server-Sent Event until a final response
+// arrives, the server closes the stream or ctx is done. onDone is called with
+// the stream's terminal error (nil on completion) after the returned channel
+// is closed.
+func (c *A2AClient) openEventStream(ctx context.Context, message *A2AMessage, onDone func(error)) (<-chan *A2AResponse, error) {
+	start := time.Now()
+	resp, err := c.post(ctx, "/api/v2/a2a/message/stream", "text/event-stream", message)
+	if err != nil {
+		c.logResponse(message, nil, err, time.Since(start))
+		return nil, err
+	}
+
+	out := make(chan *A2AResponse)
+	go func() {
+		var streamErr error
+		defer func() {
+			resp.Body.Close()
+			close(out)
+			onDone(streamErr)
+		}()
+
+		scanner := bufio.NewScanner(resp.Body)
+		scanner.Buffer(make([]byte, 0, 64*1024), int(c.config.MaxFrameBytes))
+
+		var data bytes.Buffer
+		for scanner.Scan() {
+			line := scanner.Bytes()
+			if len(line) > 0 {
+				// Only data fields matter; event names, IDs and comments
+				// are ignored
+				if value, ok := bytes.CutPrefix(line, []byte("data:")); ok {
+					if data.Len() > 0 {
+						data.WriteByte('\n')
+					}
+					data.Write(bytes.TrimPrefix(value, []byte(" ")))
+				}
+				continue
+			}
+			if data.Len() == 0 {
+				continue
+			}
+
+			var response A2AResponse
+			if err := json.Unmarshal(data.Bytes(), &response); err != nil {
+				streamErr = fmt.Errorf("failed to unmarshal stream event: %w", err)
+				return
+			}
+			data.Reset()
+
+			select {
+			case out <- &response:
+			case <-ctx.Done():
+				streamErr = ctx.Err()
+				return
+			}
+			if response.Final {
+				c.logResponse(message, &response, nil, time.Since(start))
+				streamErr = responseError(&response)
+				return
+			}
+		}
+
+		if err := scanner.Err(); err != nil {
+			if errors.Is(err, bufio.ErrTooLong) {
+				streamErr = NewA2AClientError(CodeFrameTooLarge,
+					fmt.Sprintf("Stream event exceeds the %d byte limit", c.config.MaxFrameBytes), message.ID)
+			} else {
+				streamErr = transportError(ctx, "read event stream", err)
+			}
+			c.logResponse(message, nil, streamErr, time.Since(start))
+		}
+	}()
+
+	return out, nil
+}
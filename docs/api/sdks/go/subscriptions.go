@@ -0,0 +1,130 @@
+package a2aclient
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// subscription is an active subscription request and the pooled connection
+// it was last sent on
+type subscription struct {
+	message *A2AMessage
+	link    *wsLink
+}
+
+// subscribe sends a subscription request over the WebSocket and forwards every
+// response pushed under its correlation ID until ctx is done or the returned
+// unsubscribe function is called. The request is re-sent whenever the
+// connection carrying it is lost and another one is available, so the
+// subscription survives connection loss.
+func (c *A2AClient) subscribe(ctx context.Context, message *A2AMessage) (<-chan *A2AResponse, func(), error) {
+	link := c.nextLink()
+	if link == nil {
+		return nil, nil, NewA2AClientError(CodeWebSocketRequired, "Subscriptions require an open WebSocket connection", nil)
+	}
+
+	if message.ID == "" {
+		message.ID = c.generateMessageID()
+	}
+	if message.CorrelationID == "" {
+		message.CorrelationID = message.ID
+	}
+
+	incoming := make(chan *A2AResponse, streamBufferSize)
+	unregisterPending, err := c.registerPending(message.ID, message.CorrelationID, incoming, time.Time{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sub := &subscription{message: message, link: link}
+	c.subscriptionMutex.Lock()
+	c.subscriptions[message.ID] = sub
+	c.subscriptionMutex.Unlock()
+
+	unregister := func() {
+		c.subscriptionMutex.Lock()
+		delete(c.subscriptions, message.ID)
+		c.subscriptionMutex.Unlock()
+
+		unregisterPending()
+	}
+
+	buf, err := c.encodeMessage(message)
+	if err != nil {
+		unregister()
+		return nil, nil, fmt.Errorf("failed to marshal message: %w", err)
+	}
+	err = link.write(c.frameType(), buf.Bytes())
+	releaseBuffer(buf)
+	if err != nil {
+		unregister()
+		return nil, nil, fmt.Errorf("failed to send WebSocket message: %w", err)
+	}
+
+	// unsubscribe tells the server to drop the subscription on whichever
+	// connection now carries it. Best effort; the server drops the
+	// subscription with the connection anyway.
+	unsubscribe := func() {
+		c.subscriptionMutex.Lock()
+		link := sub.link
+		c.subscriptionMutex.Unlock()
+		c.sendControl(link, message, "unsubscribe")
+	}
+
+	subCtx, cancel := context.WithCancel(ctx)
+	out := make(chan *A2AResponse)
+	go func() {
+		defer close(out)
+		defer unregister()
+
+		for {
+			select {
+			case response := <-incoming:
+				select {
+				case out <- response:
+				case <-subCtx.Done():
+					unsubscribe()
+					return
+				}
+			case <-subCtx.Done():
+				unsubscribe()
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	stop := func() {
+		once.Do(cancel)
+	}
+	return out, stop, nil
+}
+
+// restoreSubscriptions re-sends, on link, every active subscription whose
+// connection has been lost
+func (c *A2AClient) restoreSubscriptions(link *wsLink) {
+	c.subscriptionMutex.Lock()
+	var messages []*A2AMessage
+	for _, sub := range c.subscriptions {
+		if !c.pooled(sub.link) {
+			sub.link = link
+			messages = append(messages, sub.message)
+		}
+	}
+	c.subscriptionMutex.Unlock()
+
+	for _, message := range messages {
+		buf, err := c.encodeMessage(message)
+		if err != nil {
+			continue
+		}
+		err = link.write(c.frameType(), buf.Bytes())
+		releaseBuffer(buf)
+		if err != nil {
+			// The connection is gone; its loss moves them on again
+			return
+		}
+	}
+}
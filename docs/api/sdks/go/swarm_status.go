@@ -0,0 +1,102 @@
+package a2aclient
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// SwarmStatus is the decoded result of a swarm_status query
+type SwarmStatus struct {
+	SwarmID      string            `json:"swarm_id"`
+	Status       string            `json:"status"`   // e.g. "initializing", "ready", "active", "failed"
+	Topology     string            `json:"topology"` // "hierarchical", "mesh", "ring", "star"
+	Health       string            `json:"health"`   // "healthy", "degraded", "unhealthy"
+	TotalAgents  int               `json:"total_agents"`
+	AgentsByRole map[AgentRole]int `json:"agents_by_role,omitempty"`
+	ActiveAgents int               `json:"active_agents"`
+	IdleAgents   int               `json:"idle_agents"`
+	BusyAgents   int               `json:"busy_agents"`
+	Timestamp    int64             `json:"timestamp"` // unix seconds when the coordinator reported
+	// Coordinators is the number of coordinator replies merged into this
+	// status
+	Coordinators int `json:"-"`
+}
+
+// healthRank orders health values from best to worst; unknown values rank
+// as healthy
+var healthRank = map[string]int{"healthy": 0, "degraded": 1, "unhealthy": 2}
+
+// GetSwarmStatusTyped gets the swarm status decoded into a SwarmStatus. The
+// query is broadcast to every coordinator, so the result may hold several
+// replies, either as a list or under "responses"; they are merged into one
+// view: fields come from the reply with the latest timestamp, with any it
+// leaves empty filled from the next latest, except Health, which is the
+// worst any coordinator reports.
+func (c *A2AClient) GetSwarmStatusTyped(ctx context.Context, swarmID string) (*SwarmStatus, error) {
+	response, err := c.GetSwarmStatus(ctx, swarmID)
+	if err != nil {
+		return nil, err
+	}
+	if err := responseError(response); err != nil {
+		return nil, err
+	}
+
+	replies := response.Result
+	if wrapper, ok := replies.(map[string]interface{}); ok {
+		if inner, ok := wrapper["responses"]; ok {
+			replies = inner
+		}
+	}
+	if _, ok := replies.([]interface{}); !ok {
+		replies = []interface{}{replies}
+	}
+
+	var statuses []SwarmStatus
+	if err := decodeMap(replies, &statuses); err != nil {
+		return nil, NewA2AClientError(CodeDecode, fmt.Sprintf("Failed to decode swarm status: %v", err), response.Result)
+	}
+	if len(statuses) == 0 {
+		return nil, NewA2AClientError(CodeDecode, "Swarm status response has no coordinator replies", response.Result)
+	}
+	for i := range statuses {
+		if statuses[i].Timestamp == 0 {
+			statuses[i].Timestamp = response.Timestamp
+		}
+	}
+
+	return mergeSwarmStatuses(statuses), nil
+}
+
+// mergeSwarmStatuses reconciles coordinator replies into one status, as
+// described on GetSwarmStatusTyped
+func mergeSwarmStatuses(statuses []SwarmStatus) *SwarmStatus {
+	sort.SliceStable(statuses, func(i, j int) bool {
+		return statuses[i].Timestamp > statuses[j].Timestamp
+	})
+
+	merged := statuses[0]
+	for _, older := range statuses[1:] {
+		if merged.SwarmID == "" {
+			merged.SwarmID = older.SwarmID
+		}
+		if merged.Status == "" {
+			merged.Status = older.Status
+		}
+		if merged.Topology == "" {
+			merged.Topology = older.Topology
+		}
+		if merged.TotalAgents == 0 && merged.AgentsByRole == nil {
+			merged.TotalAgents = older.TotalAgents
+			merged.AgentsByRole = older.AgentsByRole
+			merged.ActiveAgents = older.ActiveAgents
+			merged.IdleAgents = older.IdleAgents
+			merged.BusyAgents = older.BusyAgents
+		}
+		if healthRank[older.Health] > healthRank[merged.Health] || merged.Health == "" {
+			merged.Health = older.Health
+		}
+	}
+	merged.Coordinators = len(statuses)
+	return &merged
+}
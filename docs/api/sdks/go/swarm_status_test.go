@@ -0,0 +1,49 @@
+package a2aclient
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGetSwarmStatusTypedMergesCoordinators(t *testing.T) {
+	client := memoryClient(func(_ context.Context, message *A2AMessage) (*A2AResponse, error) {
+		if message.Parameters["swarmId"] != "swarm-1" {
+			t.Errorf("params %v, want swarmId swarm-1", message.Parameters)
+		}
+		return &A2AResponse{Success: true, Timestamp: 100, Result: map[string]interface{}{"responses": []interface{}{
+			map[string]interface{}{"swarm_id": "swarm-1", "status": "active", "topology": "mesh", "health": "healthy",
+				"total_agents": 4, "agents_by_role": map[string]interface{}{"coder": 3, "coordinator": 1}, "active_agents": 3, "timestamp": 50},
+			// Latest, but partial and degraded
+			map[string]interface{}{"status": "ready", "health": "degraded", "timestamp": 90},
+			// No timestamp: takes the response's
+			map[string]interface{}{"health": "healthy"},
+		}}}, nil
+	}, nil)
+
+	status, err := client.GetSwarmStatusTyped(context.Background(), "swarm-1")
+	if err != nil {
+		t.Fatalf("GetSwarmStatusTyped: %v", err)
+	}
+	if status.Coordinators != 3 || status.Timestamp != 100 {
+		t.Errorf("merged %d replies at %d, want 3 at 100", status.Coordinators, status.Timestamp)
+	}
+	if status.Status != "ready" || status.SwarmID != "swarm-1" || status.Topology != "mesh" {
+		t.Errorf("status %q, swarm %q, topology %q, want ready, swarm-1, mesh", status.Status, status.SwarmID, status.Topology)
+	}
+	if status.Health != "degraded" {
+		t.Errorf("health %q, want the worst reported, degraded", status.Health)
+	}
+	if status.TotalAgents != 4 || status.AgentsByRole[AgentRoleCoder] != 3 || status.ActiveAgents != 3 {
+		t.Errorf("agent counts %+v, want those of the only reply with counts", status)
+	}
+}
+
+func TestGetSwarmStatusTypedRejectsMalformedResult(t *testing.T) {
+	client := memoryClient(func(context.Context, *A2AMessage) (*A2AResponse, error) {
+		return &A2AResponse{Success: true, Result: map[string]interface{}{"total_agents": "many"}}, nil
+	}, nil)
+
+	if _, err := client.GetSwarmStatusTyped(context.Background(), ""); !HasCode(err, CodeDecode) {
+		t.Errorf("got %v, want DECODE_ERROR", err)
+	}
+}
@@ -0,0 +1,80 @@
+package a2aclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// maxTaskPollInterval caps the backoff between WaitForTaskCompletion polls
+const maxTaskPollInterval = 30 * time.Second
+
+// WaitForTaskCompletion polls the task's status until it is "completed",
+// then returns its task_results response. Polls start pollInterval apart
+// (default 1s) and back off exponentially up to 30s. It fails with
+// TASK_FAILED if the task reports "failed" or "error", or when ctx is done.
+// Polls bypass the response cache.
+func (c *A2AClient) WaitForTaskCompletion(ctx context.Context, taskID string, pollInterval time.Duration) (*A2AResponse, error) {
+	ctx = WithoutCache(ctx)
+	if pollInterval <= 0 {
+		pollInterval = time.Second
+	}
+	timer := time.NewTimer(pollInterval)
+	defer timer.Stop()
+
+	for {
+		response, err := c.SendMessage(ctx, taskMessage(MCPToolClaudeFlowTaskStatus, taskID))
+		if err == nil {
+			err = responseError(response)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get status of task %s: %w", taskID, err)
+		}
+
+		switch status := resultString(response.Result, "status"); status {
+		case "completed":
+			results, err := c.SendMessage(ctx, taskMessage(MCPToolClaudeFlowTaskResults, taskID))
+			if err == nil {
+				err = responseError(results)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to get results of task %s: %w", taskID, err)
+			}
+			return results, nil
+		case "failed", "error":
+			return nil, NewA2AClientError(CodeTaskFailed, fmt.Sprintf("Task %s reported status %q", taskID, status), response.Result)
+		}
+
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return nil, fmt.Errorf("task %s not completed: %w", taskID, ctx.Err())
+		}
+		pollInterval *= 2
+		if pollInterval > maxTaskPollInterval {
+			pollInterval = maxTaskPollInterval
+		}
+		timer.Reset(pollInterval)
+	}
+}
+
+// taskMessage builds a task_status or task_results message for a task
+func taskMessage(tool MCPToolName, taskID string) *A2AMessage {
+	return &A2AMessage{
+		Target: AgentTarget{
+			GroupTarget: &GroupTarget{
+				Type: "group",
+				Role: AgentRoleTaskOrchestrator,
+			},
+		},
+		ToolName: tool,
+		Parameters: map[string]interface{}{
+			"taskId": taskID,
+		},
+		Coordination: CoordinationMode{
+			DirectCoordination: &DirectCoordination{
+				Mode: "direct",
+			},
+		},
+	}
+}
@@ -0,0 +1,48 @@
+package a2aclient
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestWaitForTaskCompletionPolls(t *testing.T) {
+	statuses := []string{"running", "running", "completed"}
+	var polls int
+	client := memoryClient(func(_ context.Context, message *A2AMessage) (*A2AResponse, error) {
+		if message.Parameters["taskId"] != "task-1" {
+			t.Errorf("%s for task %v, want task-1", message.ToolName, message.Parameters["taskId"])
+		}
+		switch message.ToolName {
+		case MCPToolClaudeFlowTaskStatus:
+			status := statuses[polls]
+			polls++
+			return &A2AResponse{MessageID: message.ID, Success: true, Result: map[string]interface{}{"status": status}}, nil
+		case MCPToolClaudeFlowTaskResults:
+			return &A2AResponse{MessageID: message.ID, Success: true, Result: map[string]interface{}{"output": "done"}}, nil
+		}
+		t.Errorf("unexpected tool %s", message.ToolName)
+		return nil, nil
+	}, nil)
+
+	results, err := client.WaitForTaskCompletion(context.Background(), "task-1", time.Millisecond)
+	if err != nil {
+		t.Fatalf("WaitForTaskCompletion: %v", err)
+	}
+	if polls != len(statuses) {
+		t.Errorf("polled %d times, want %d", polls, len(statuses))
+	}
+	if output := resultString(results.Result, "output"); output != "done" {
+		t.Errorf("got results %v, want the task_results response", results.Result)
+	}
+}
+
+func TestWaitForTaskCompletionReportsFailure(t *testing.T) {
+	client := memoryClient(func(_ context.Context, message *A2AMessage) (*A2AResponse, error) {
+		return &A2AResponse{MessageID: message.ID, Success: true, Result: map[string]interface{}{"status": "failed"}}, nil
+	}, nil)
+
+	if _, err := client.WaitForTaskCompletion(context.Background(), "task-1", time.Millisecond); !HasCode(err, CodeTaskFailed) {
+		t.Errorf("got %v, want TASK_FAILED", err)
+	}
+}
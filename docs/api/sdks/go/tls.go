@@ -0,0 +1,132 @@
+package a2aclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+)
+
+// buildTLSConfig builds the TLS configuration shared by the HTTP transport and
+// the WebSocket dialer. It returns nil when no certificate is configured. The
+// client certificate is optional and its key may be passphrase-protected;
+// without a CAFile the system roots are used to verify the server. With
+// WatchCertificates the client certificate is supplied per handshake by a
+// certReloader instead.
+func buildTLSConfig(certificate *A2ACertificate) (*tls.Config, error) {
+	if certificate == nil {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{}
+
+	if certificate.CertFile != "" || certificate.KeyFile != "" {
+		cert, err := loadClientCertificate(certificate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		if certificate.WatchCertificates {
+			reloader := &certReloader{certificate: certificate, cert: &cert}
+			reloader.stamp, _ = fileStamp(certificate.CertFile, certificate.KeyFile)
+			reloader.checked = time.Now()
+			tlsConfig.GetClientCertificate = reloader.clientCertificate
+		} else {
+			tlsConfig.Certificates = []tls.Certificate{cert}
+		}
+	}
+
+	if certificate.CAFile != "" {
+		caPEM, err := os.ReadFile(certificate.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("failed to parse CA file %s: no PEM certificates found", certificate.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return tlsConfig, nil
+}
+
+// certRecheckInterval is how long a certReloader trusts its last look at
+// the certificate files, so a burst of handshakes does not stat them each
+const certRecheckInterval = time.Second
+
+// certReloader supplies the client certificate for each handshake, loading
+// it again from disk when the certificate or key file has changed since the
+// last load. The files are checked at most once per certRecheckInterval.
+type certReloader struct {
+	certificate *A2ACertificate
+
+	mu      sync.Mutex
+	cert    *tls.Certificate
+	stamp   string
+	checked time.Time
+}
+
+// clientCertificate implements tls.Config.GetClientCertificate. If the files
+// cannot be loaded, e.g. because a rotation has written the certificate but
+// not yet the matching key, the previous certificate is used and loading is
+// tried again at the next check.
+func (r *certReloader) clientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if time.Since(r.checked) < certRecheckInterval {
+		return r.cert, nil
+	}
+	r.checked = time.Now()
+
+	stamp, err := fileStamp(r.certificate.CertFile, r.certificate.KeyFile)
+	if err != nil || stamp == r.stamp {
+		return r.cert, nil
+	}
+
+	cert, err := loadClientCertificate(r.certificate)
+	if err != nil {
+		return r.cert, nil
+	}
+	r.cert, r.stamp = &cert, stamp
+	return r.cert, nil
+}
+
+// fileStamp identifies the current version of the files by size and
+// modification time
+func fileStamp(paths ...string) (string, error) {
+	var stamp string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return "", err
+		}
+		stamp += fmt.Sprintf("%d:%d;", info.Size(), info.ModTime().UnixNano())
+	}
+	return stamp, nil
+}
+
+// isTLSError reports whether err is a failed TLS handshake: a certificate
+// the client or the server rejected, or a peer not speaking TLS. Such
+// failures do not pass on retry.
+func isTLSError(err error) bool {
+	var (
+		verifyErr    *tls.CertificateVerificationError
+		authorityErr x509.UnknownAuthorityError
+		hostnameErr  x509.HostnameError
+		invalidErr   x509.CertificateInvalidError
+		recordErr    tls.RecordHeaderError
+		alertErr     tls.AlertError
+		opErr        *net.OpError
+	)
+	return errors.As(err, &verifyErr) ||
+		errors.As(err, &authorityErr) ||
+		errors.As(err, &hostnameErr) ||
+		errors.As(err, &invalidErr) ||
+		errors.As(err, &recordErr) ||
+		errors.As(err, &alertErr) ||
+		(errors.As(err, &opErr) && opErr.Op == "remote error")
+}
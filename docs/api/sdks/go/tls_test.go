@@ -0,0 +1,94 @@
+package a2aclient
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeClientCertificate writes a new self-signed certificate and its key
+// for commonName, stamping both files with modified
+func writeClientCertificate(t *testing.T, certFile, keyFile, commonName string, modified time.Time) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files := map[string]*pem.Block{
+		certFile: {Type: "CERTIFICATE", Bytes: certDER},
+		keyFile:  {Type: "PRIVATE KEY", Bytes: keyDER},
+	}
+	for path, block := range files {
+		if err := os.WriteFile(path, pem.EncodeToMemory(block), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, modified, modified); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestCertReloaderPicksUpRotation(t *testing.T) {
+	dir := t.TempDir()
+	certificate := &A2ACertificate{
+		CertFile:          filepath.Join(dir, "client.crt"),
+		KeyFile:           filepath.Join(dir, "client.key"),
+		WatchCertificates: true,
+	}
+	start := time.Now().Add(-time.Minute)
+	writeClientCertificate(t, certificate.CertFile, certificate.KeyFile, "original", start)
+
+	tlsConfig, err := buildTLSConfig(certificate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	commonName := func() string {
+		t.Helper()
+		cert, err := tlsConfig.GetClientCertificate(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		leaf, err := x509.ParseCertificate(cert.Certificate[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		return leaf.Subject.CommonName
+	}
+	if name := commonName(); name != "original" {
+		t.Fatalf("presented %q, want original", name)
+	}
+
+	writeClientCertificate(t, certificate.CertFile, certificate.KeyFile, "rotated", start.Add(time.Second))
+	// Within the re-check interval the files are not looked at
+	if name := commonName(); name != "original" {
+		t.Errorf("presented %q straight after rotation, want the cached original", name)
+	}
+
+	time.Sleep(certRecheckInterval)
+	if name := commonName(); name != "rotated" {
+		t.Errorf("presented %q after the re-check interval, want rotated", name)
+	}
+}
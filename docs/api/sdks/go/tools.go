@@ -0,0 +1,147 @@
+package a2aclient
+
+import (
+	"reflect"
+	"runtime"
+	"strings"
+)
+
+// ToolInfo describes an MCP tool and whether the client has a high-level
+// helper for it. Tools without a helper are called with SendMessage.
+type ToolInfo struct {
+	Name         MCPToolName
+	Category     string
+	HasHelper    bool
+	HelperMethod string // comma-separated when several helpers wrap the tool
+}
+
+// toolCategories groups every MCP tool constant by category, in the order
+// they are declared
+var toolCategories = []struct {
+	category string
+	tools    []MCPToolName
+}{
+	{"Core Infrastructure", []MCPToolName{
+		MCPToolClaudeFlowSwarmInit, MCPToolClaudeFlowSwarmStatus, MCPToolClaudeFlowSwarmMonitor,
+		MCPToolClaudeFlowSwarmScale, MCPToolClaudeFlowSwarmDestroy, MCPToolRuvSwarmSwarmInit,
+		MCPToolRuvSwarmSwarmStatus, MCPToolRuvSwarmSwarmMonitor, MCPToolClaudeFlowAgentSpawn,
+		MCPToolClaudeFlowAgentList, MCPToolClaudeFlowAgentMetrics, MCPToolRuvSwarmAgentSpawn,
+		MCPToolRuvSwarmAgentList, MCPToolRuvSwarmAgentMetrics, MCPToolClaudeFlowTopologyOptimize,
+		MCPToolClaudeFlowCoordinationSync,
+	}},
+	{"Task Orchestration", []MCPToolName{
+		MCPToolClaudeFlowTaskOrchestrate, MCPToolClaudeFlowTaskStatus, MCPToolClaudeFlowTaskResults,
+		MCPToolRuvSwarmTaskOrchestrate, MCPToolRuvSwarmTaskStatus, MCPToolRuvSwarmTaskResults,
+		MCPToolClaudeFlowParallelExecute, MCPToolClaudeFlowBatchProcess, MCPToolClaudeFlowLoadBalance,
+		MCPToolClaudeFlowWorkflowCreate, MCPToolClaudeFlowWorkflowExecute, MCPToolClaudeFlowWorkflowExport,
+	}},
+	{"Memory & State Management", []MCPToolName{
+		MCPToolClaudeFlowMemoryUsage, MCPToolClaudeFlowMemorySearch, MCPToolClaudeFlowMemoryPersist,
+		MCPToolClaudeFlowMemoryNamespace, MCPToolClaudeFlowMemoryBackup, MCPToolClaudeFlowMemoryRestore,
+		MCPToolClaudeFlowMemoryCompress, MCPToolClaudeFlowMemorySync, MCPToolClaudeFlowMemoryAnalytics,
+		MCPToolRuvSwarmMemoryUsage, MCPToolClaudeFlowStateSnapshot, MCPToolClaudeFlowContextRestore,
+		MCPToolClaudeFlowCacheManage, MCPToolClaudeFlowConfigManage,
+	}},
+	{"Neural & AI Operations", []MCPToolName{
+		MCPToolClaudeFlowNeuralStatus, MCPToolClaudeFlowNeuralTrain, MCPToolClaudeFlowNeuralPatterns,
+		MCPToolClaudeFlowNeuralPredict, MCPToolClaudeFlowNeuralCompress, MCPToolClaudeFlowNeuralExplain,
+		MCPToolRuvSwarmNeuralStatus, MCPToolRuvSwarmNeuralTrain, MCPToolRuvSwarmNeuralPatterns,
+		MCPToolClaudeFlowModelLoad, MCPToolClaudeFlowModelSave, MCPToolClaudeFlowInferenceRun,
+		MCPToolClaudeFlowPatternRecognize, MCPToolClaudeFlowCognitiveAnalyze, MCPToolClaudeFlowLearningAdapt,
+		MCPToolClaudeFlowEnsembleCreate, MCPToolClaudeFlowTransferLearn,
+	}},
+	{"DAA Systems", []MCPToolName{
+		MCPToolClaudeFlowDAAAgentCreate, MCPToolClaudeFlowDAACapabilityMatch, MCPToolClaudeFlowDAAResourceAlloc,
+		MCPToolClaudeFlowDAALifecycleManage, MCPToolClaudeFlowDAACommunication, MCPToolClaudeFlowDAAConsensus,
+		MCPToolClaudeFlowDAAFaultTolerance, MCPToolClaudeFlowDAAOptimization, MCPToolRuvSwarmDAAInit,
+		MCPToolRuvSwarmDAAAgentCreate, MCPToolRuvSwarmDAAAgentAdapt, MCPToolRuvSwarmDAAWorkflowCreate,
+		MCPToolRuvSwarmDAAWorkflowExecute, MCPToolRuvSwarmDAAKnowledgeShare, MCPToolRuvSwarmDAALearningStatus,
+		MCPToolRuvSwarmDAACognitivePattern, MCPToolRuvSwarmDAAMetaLearning, MCPToolRuvSwarmDAAPerformanceMetrics,
+	}},
+	{"Performance & Analytics", []MCPToolName{
+		MCPToolClaudeFlowPerformanceReport, MCPToolClaudeFlowBottleneckAnalyze, MCPToolClaudeFlowTokenUsage,
+		MCPToolClaudeFlowBenchmarkRun, MCPToolClaudeFlowMetricsCollect, MCPToolClaudeFlowTrendAnalysis,
+		MCPToolRuvSwarmBenchmarkRun, MCPToolClaudeFlowCostAnalysis, MCPToolClaudeFlowQualityAssess,
+		MCPToolClaudeFlowErrorAnalysis, MCPToolClaudeFlowUsageStats, MCPToolClaudeFlowHealthCheck,
+	}},
+	{"GitHub Integration", []MCPToolName{
+		MCPToolClaudeFlowGitHubRepoAnalyze, MCPToolClaudeFlowGitHubMetrics, MCPToolClaudeFlowGitHubPRManage,
+		MCPToolClaudeFlowGitHubCodeReview, MCPToolClaudeFlowGitHubIssueTrack, MCPToolClaudeFlowGitHubReleaseCoord,
+		MCPToolClaudeFlowGitHubWorkflowAuto, MCPToolClaudeFlowGitHubSyncCoord,
+	}},
+	{"Workflow & Automation", []MCPToolName{
+		MCPToolClaudeFlowAutomationSetup, MCPToolClaudeFlowPipelineCreate, MCPToolClaudeFlowSchedulerManage,
+		MCPToolClaudeFlowTriggerSetup, MCPToolClaudeFlowWorkflowTemplate, MCPToolClaudeFlowSparcMode,
+	}},
+	{"System Infrastructure", []MCPToolName{
+		MCPToolClaudeFlowTerminalExecute, MCPToolClaudeFlowFeaturesDetect, MCPToolClaudeFlowSecurityScan,
+		MCPToolClaudeFlowBackupCreate, MCPToolClaudeFlowRestoreSystem, MCPToolClaudeFlowLogAnalysis,
+		MCPToolClaudeFlowDiagnosticRun, MCPToolClaudeFlowWasmOptimize, MCPToolRuvSwarmFeaturesDetect,
+	}},
+}
+
+// toolHelpers maps tools to the client methods that wrap them. Entries are
+// method expressions rather than names so that renaming or removing a helper
+// fails to compile instead of leaving the registry stale.
+var toolHelpers = map[MCPToolName][]interface{}{
+	MCPToolClaudeFlowSwarmInit:         {(*A2AClient).InitializeSwarm, (*A2AClient).BootstrapSwarm},
+	MCPToolRuvSwarmSwarmInit:           {(*A2AClient).InitializeSwarm, (*A2AClient).BootstrapSwarm},
+	MCPToolClaudeFlowSwarmStatus:       {(*A2AClient).GetSwarmStatus, (*A2AClient).GetSwarmStatusTyped, (*A2AClient).WaitForSwarmReady, (*A2AClient).BootstrapSwarm},
+	MCPToolClaudeFlowSwarmDestroy:      {(*A2AClient).BootstrapSwarm},
+	MCPToolClaudeFlowAgentSpawn:        {(*A2AClient).SpawnAgent, (*A2AClient).BootstrapSwarm},
+	MCPToolClaudeFlowAgentMetrics:      {(*A2AClient).GetResourceHistory, (*A2AClient).GetAgentMetrics, (*A2AClient).GetGroupMetrics},
+	MCPToolClaudeFlowAgentList:         {(*A2AClient).ListAgents, (*A2AClient).ListAgentsPaged, (*A2AClient).IterateAgents, (*A2AClient).ResolveGroupTarget, (*A2AClient).FindAgents, (*A2AClient).ResolveConditionalTarget, (*A2AClient).BroadcastAndAggregate},
+	MCPToolClaudeFlowTaskOrchestrate:   {(*A2AClient).OrchestrateTask},
+	MCPToolClaudeFlowTaskStatus:        {(*A2AClient).WaitForTaskCompletion},
+	MCPToolClaudeFlowTaskResults:       {(*A2AClient).WaitForTaskCompletion},
+	MCPToolClaudeFlowMemoryUsage:       {(*A2AClient).StoreMemory, (*A2AClient).RetrieveMemory, (*A2AClient).StoreMemoryBatch, (*A2AClient).RetrieveMemoryBatch},
+	MCPToolClaudeFlowInferenceRun:      {(*A2AClient).StreamInference},
+	MCPToolClaudeFlowNeuralTrain:       {(*A2AClient).NeuralTrain, (*A2AClient).NeuralTrainStream},
+	MCPToolClaudeFlowDAAConsensus:      {(*A2AClient).DAAConsensus},
+	MCPToolClaudeFlowGitHubRepoAnalyze: {(*A2AClient).AnalyzeRepo},
+	MCPToolClaudeFlowPerformanceReport: {(*A2AClient).PerformanceReport, (*A2AClient).RecommendAgentCount, (*A2AClient).ExplainAgentCount},
+	MCPToolClaudeFlowUsageStats:        {(*A2AClient).RecommendAgentCount, (*A2AClient).ExplainAgentCount},
+	MCPToolClaudeFlowBenchmarkRun:      {(*A2AClient).RunBenchmark},
+	MCPToolClaudeFlowWorkflowCreate:    {(*A2AClient).CreateWorkflow},
+	MCPToolClaudeFlowWorkflowExecute:   {(*A2AClient).ExecuteWorkflow},
+	MCPToolClaudeFlowWorkflowExport:    {(*A2AClient).ExportWorkflow},
+	MCPToolClaudeFlowSchedulerManage:   {(*A2AClient).ManageSchedule},
+	MCPToolClaudeFlowSecurityScan:      {(*A2AClient).SecurityScan},
+	MCPToolClaudeFlowBackupCreate:      {(*A2AClient).CreateBackup},
+	MCPToolClaudeFlowRestoreSystem:     {(*A2AClient).RestoreSystem},
+	MCPToolClaudeFlowTopologyOptimize:  {(*A2AClient).OptimizeTopology, (*A2AClient).OptimizeTopologyAndApply},
+	MCPToolClaudeFlowSwarmScale:        {(*A2AClient).OptimizeTopologyAndApply},
+	MCPToolClaudeFlowTriggerSetup:      {(*A2AClient).SubscribeTriggers},
+	MCPToolClaudeFlowCacheManage:       {(*A2AClient).InvalidateCacheBatch, (*A2AClient).InvalidateNamespace},
+	MCPToolClaudeFlowHealthCheck:       {(*A2AClient).HealthCheck, (*A2AClient).Ping, (*A2AClient).ProbeAgentLatency},
+}
+
+// SupportedTools lists every MCP tool with its category and the high-level
+// helper methods that wrap it, if any
+func SupportedTools() []ToolInfo {
+	var tools []ToolInfo
+	for _, group := range toolCategories {
+		for _, name := range group.tools {
+			info := ToolInfo{Name: name, Category: group.category}
+			if helpers := toolHelpers[name]; len(helpers) > 0 {
+				names := make([]string, len(helpers))
+				for i, helper := range helpers {
+					names[i] = helperMethodName(helper)
+				}
+				info.HasHelper = true
+				info.HelperMethod = strings.Join(names, ", ")
+			}
+			tools = append(tools, info)
+		}
+	}
+	return tools
+}
+
+// helperMethodName returns the bare method name of a method expression
+func helperMethodName(method interface{}) string {
+	name := runtime.FuncForPC(reflect.ValueOf(method).Pointer()).Name()
+	if index := strings.LastIndex(name, "."); index >= 0 {
+		name = name[index+1:]
+	}
+	return strings.TrimSuffix(name, "-fm")
+}
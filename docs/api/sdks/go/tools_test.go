@@ -0,0 +1,137 @@
+package a2aclient
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// TestToolHelpersRegistered fails when an exported *A2AClient method sends a
+// tool, directly or through the functions and methods it calls, without
+// being listed under that tool in toolHelpers. Calls are matched by name, so
+// the check errs on the side of listing too much.
+func TestToolHelpersRegistered(t *testing.T) {
+	fset := token.NewFileSet()
+	packages, err := parser.ParseDir(fset, ".", func(info fs.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Tool constant values, then the constants and unexported callees of
+	// every function and method, by name
+	values := make(map[string]string)
+	tools := make(map[string]map[string]bool)
+	callees := make(map[string]map[string]bool)
+	exported := make(map[string]bool)
+	for _, file := range packages["a2aclient"].Files {
+		for _, decl := range file.Decls {
+			if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.CONST {
+				for _, spec := range gen.Specs {
+					spec := spec.(*ast.ValueSpec)
+					for i, ident := range spec.Names {
+						if lit, ok := valueAt(spec, i).(*ast.BasicLit); ok && strings.HasPrefix(ident.Name, "MCPTool") {
+							values[ident.Name], _ = strconv.Unquote(lit.Value)
+						}
+					}
+				}
+			}
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+			name := fn.Name.Name
+			if fn.Recv != nil {
+				if !isClientReceiver(fn.Recv) {
+					continue
+				}
+				if ast.IsExported(name) {
+					exported[name] = true
+				}
+			}
+			if tools[name] == nil {
+				tools[name] = make(map[string]bool)
+				callees[name] = make(map[string]bool)
+			}
+			ast.Inspect(fn.Body, func(node ast.Node) bool {
+				switch node := node.(type) {
+				case *ast.Ident:
+					if strings.HasPrefix(node.Name, "MCPTool") && node.Name != "MCPToolName" {
+						tools[name][node.Name] = true
+					} else {
+						callees[name][node.Name] = true
+					}
+				case *ast.SelectorExpr:
+					callees[name][node.Sel.Name] = true
+				}
+				return true
+			})
+		}
+	}
+
+	registered := make(map[string]map[string]bool)
+	for tool, helpers := range toolHelpers {
+		for _, helper := range helpers {
+			method := helperMethodName(helper)
+			if registered[method] == nil {
+				registered[method] = make(map[string]bool)
+			}
+			registered[method][string(tool)] = true
+		}
+	}
+	var missing []string
+	for method := range exported {
+		sent := make(map[string]bool)
+		collectTools(method, tools, callees, make(map[string]bool), sent)
+		for constant := range sent {
+			if !registered[method][values[constant]] {
+				missing = append(missing, method+" -> "+constant)
+			}
+		}
+	}
+	sort.Strings(missing)
+	for _, entry := range missing {
+		t.Errorf("toolHelpers does not list %s", entry)
+	}
+}
+
+// collectTools gathers the tool constants fn refers to, following calls into
+// unexported functions and methods
+func collectTools(fn string, tools, callees map[string]map[string]bool, seen, sent map[string]bool) {
+	if seen[fn] {
+		return
+	}
+	seen[fn] = true
+	for tool := range tools[fn] {
+		sent[tool] = true
+	}
+	for callee := range callees[fn] {
+		if _, ok := tools[callee]; ok {
+			collectTools(callee, tools, callees, seen, sent)
+		}
+	}
+}
+
+// isClientReceiver reports whether a method receiver is *A2AClient
+func isClientReceiver(recv *ast.FieldList) bool {
+	star, ok := recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	ident, ok := star.X.(*ast.Ident)
+	return ok && ident.Name == "A2AClient"
+}
+
+// valueAt returns the i'th value of a const spec, if it has one
+func valueAt(spec *ast.ValueSpec, i int) ast.Expr {
+	if i < len(spec.Values) {
+		return spec.Values[i]
+	}
+	return nil
+}
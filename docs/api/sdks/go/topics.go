@@ -0,0 +1,79 @@
+package a2aclient
+
+import (
+	"context"
+)
+
+// topicSubscription is a Subscribe channel and the signal that ends it
+type topicSubscription struct {
+	ch   chan *A2AResponse
+	done chan struct{}
+}
+
+// Subscribe returns a channel receiving every event the server pushes on
+// topic over the WebSocket. The server marks a pushed event by setting the
+// response's "topic" field; responses that answer a request are matched by
+// correlation ID first and never reach topic subscribers. Each subscriber
+// gets its own copy of the stream, and events are dropped for a subscriber
+// that falls more than streamBufferSize events behind. The channel is closed
+// when ctx is done or Unsubscribe is called, and it stays open across
+// reconnects.
+func (c *A2AClient) Subscribe(ctx context.Context, topic string) (<-chan *A2AResponse, error) {
+	if topic == "" {
+		return nil, NewA2AClientError(CodeValidation, "Topic is required", nil)
+	}
+
+	sub := &topicSubscription{
+		ch:   make(chan *A2AResponse, streamBufferSize),
+		done: make(chan struct{}),
+	}
+	c.topicMutex.Lock()
+	c.topics[topic] = append(c.topics[topic], sub)
+	c.topicMutex.Unlock()
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			c.Unsubscribe(sub.ch)
+		case <-sub.done:
+		}
+	}()
+	return sub.ch, nil
+}
+
+// Unsubscribe stops delivery to a channel returned by Subscribe and closes
+// it. Unsubscribing a channel twice is a no-op.
+func (c *A2AClient) Unsubscribe(subscription <-chan *A2AResponse) {
+	c.topicMutex.Lock()
+	defer c.topicMutex.Unlock()
+
+	for topic, subscribers := range c.topics {
+		for i, sub := range subscribers {
+			if sub.ch != subscription {
+				continue
+			}
+			subscribers = append(subscribers[:i:i], subscribers[i+1:]...)
+			if len(subscribers) == 0 {
+				delete(c.topics, topic)
+			} else {
+				c.topics[topic] = subscribers
+			}
+			close(sub.ch)
+			close(sub.done)
+			return
+		}
+	}
+}
+
+// publish delivers a pushed event to every subscriber of its topic
+func (c *A2AClient) publish(response *A2AResponse) {
+	c.topicMutex.RLock()
+	defer c.topicMutex.RUnlock()
+
+	for _, sub := range c.topics[response.Topic] {
+		select {
+		case sub.ch <- response:
+		default:
+		}
+	}
+}
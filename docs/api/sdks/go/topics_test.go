@@ -0,0 +1,52 @@
+package a2aclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestSubscribersEachReceiveEvent(t *testing.T) {
+	server := newWSServer(t, func(conn *websocket.Conn, message *A2AMessage) *A2AResponse {
+		// Push an event ahead of the answer
+		conn.WriteJSON(&A2AResponse{MessageID: "event-1", Topic: "alerts", Success: true, Result: "disk full"})
+		return echoResult(message)
+	})
+	client := connectWS(t, server, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	first, err := client.Subscribe(ctx, "alerts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := client.Subscribe(ctx, "alerts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := client.Subscribe(ctx, "metrics")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowSwarmStatus, nil)); err != nil {
+		t.Fatalf("SendMessage: %v", err)
+	}
+	for i, subscription := range []<-chan *A2AResponse{first, second} {
+		select {
+		case event := <-subscription:
+			if event.MessageID != "event-1" || event.Result != "disk full" {
+				t.Errorf("subscriber %d got %+v, want event-1", i+1, event)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("subscriber %d received nothing", i+1)
+		}
+	}
+	select {
+	case event := <-other:
+		t.Errorf("subscriber of another topic got %+v", event)
+	default:
+	}
+}
@@ -0,0 +1,94 @@
+package a2aclient
+
+import (
+	"context"
+	"fmt"
+)
+
+// TopologyRecommendation is the decoded result of a topology optimization
+type TopologyRecommendation struct {
+	SwarmID             string  `json:"swarm_id"`
+	CurrentTopology     string  `json:"current_topology"`
+	RecommendedTopology string  `json:"recommended_topology"` // "hierarchical", "mesh", "ring", "star"
+	ExpectedImprovement float64 `json:"expected_improvement"` // relative gain, e.g. 0.2 for 20%
+	Reasoning           string  `json:"reasoning"`
+	// Applied reports whether OptimizeTopologyAndApply reconfigured the swarm
+	Applied bool `json:"-"`
+}
+
+// OptimizeTopology asks the coordinator which topology would suit the swarm
+// best. The swarm is left unchanged; see OptimizeTopologyAndApply.
+func (c *A2AClient) OptimizeTopology(ctx context.Context, swarmID string) (*TopologyRecommendation, error) {
+	if swarmID == "" {
+		return nil, NewA2AClientError(CodeValidation, "Swarm ID is required", nil)
+	}
+
+	response, err := c.SendMessage(ctx, coordinatorMessage(MCPToolClaudeFlowTopologyOptimize, map[string]interface{}{
+		"swarmId": swarmID,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	if err := responseError(response); err != nil {
+		return nil, err
+	}
+
+	var recommendation TopologyRecommendation
+	if err := decodeMap(response.Result, &recommendation); err != nil {
+		return nil, NewA2AClientError(CodeDecode, fmt.Sprintf("Failed to decode topology recommendation: %v", err), response.Result)
+	}
+	if recommendation.RecommendedTopology == "" {
+		return nil, NewA2AClientError(CodeDecode, "Topology optimization response has no recommended topology", response.Result)
+	}
+	if recommendation.SwarmID == "" {
+		recommendation.SwarmID = swarmID
+	}
+	return &recommendation, nil
+}
+
+// OptimizeTopologyAndApply gets a topology recommendation and, if it differs
+// from the current topology, reconfigures the swarm to it with swarm_scale.
+// If reconfiguring fails the recommendation is returned with the error.
+func (c *A2AClient) OptimizeTopologyAndApply(ctx context.Context, swarmID string) (*TopologyRecommendation, error) {
+	recommendation, err := c.OptimizeTopology(ctx, swarmID)
+	if err != nil {
+		return nil, err
+	}
+	if recommendation.RecommendedTopology == recommendation.CurrentTopology {
+		return recommendation, nil
+	}
+
+	response, err := c.SendMessage(ctx, coordinatorMessage(MCPToolClaudeFlowSwarmScale, map[string]interface{}{
+		"swarmId":  swarmID,
+		"topology": recommendation.RecommendedTopology,
+	}))
+	if err == nil {
+		err = responseError(response)
+	}
+	if err != nil {
+		return recommendation, err
+	}
+	recommendation.Applied = true
+	return recommendation, nil
+}
+
+// coordinatorMessage builds a message for a single coordinator agent
+func coordinatorMessage(toolName MCPToolName, params map[string]interface{}) *A2AMessage {
+	return &A2AMessage{
+		Target: AgentTarget{
+			GroupTarget: &GroupTarget{
+				Type:              "group",
+				Role:              AgentRoleCoordinator,
+				MaxAgents:         intPtr(1),
+				SelectionStrategy: "load-balanced",
+			},
+		},
+		ToolName:   toolName,
+		Parameters: params,
+		Coordination: CoordinationMode{
+			DirectCoordination: &DirectCoordination{
+				Mode: "direct",
+			},
+		},
+	}
+}
@@ -0,0 +1,70 @@
+package a2aclient
+
+import (
+	"context"
+	"testing"
+)
+
+func TestOptimizeTopologyAndApply(t *testing.T) {
+	var scaled []string
+	client := memoryClient(func(_ context.Context, message *A2AMessage) (*A2AResponse, error) {
+		swarmID := message.Parameters["swarmId"].(string)
+		if message.ToolName == MCPToolClaudeFlowSwarmScale {
+			if swarmID == "locked" {
+				return &A2AResponse{Error: &A2AError{Code: "SWARM_BUSY", Message: "scaling in progress"}}, nil
+			}
+			scaled = append(scaled, swarmID+":"+message.Parameters["topology"].(string))
+			return &A2AResponse{Success: true}, nil
+		}
+		current := "mesh"
+		if swarmID == "optimal" {
+			current = "hierarchical"
+		}
+		return &A2AResponse{Success: true, Result: map[string]interface{}{
+			"current_topology":     current,
+			"recommended_topology": "hierarchical",
+			"expected_improvement": 0.2,
+		}}, nil
+	}, nil)
+	ctx := context.Background()
+
+	if _, err := client.OptimizeTopology(ctx, ""); !HasCode(err, CodeValidation) {
+		t.Errorf("no swarm ID: got %v, want VALIDATION_ERROR", err)
+	}
+
+	recommendation, err := client.OptimizeTopology(ctx, "swarm-1")
+	if err != nil {
+		t.Fatalf("OptimizeTopology: %v", err)
+	}
+	if recommendation.SwarmID != "swarm-1" || recommendation.ExpectedImprovement != 0.2 || recommendation.Applied {
+		t.Errorf("recommendation %+v", recommendation)
+	}
+	if len(scaled) != 0 {
+		t.Fatalf("OptimizeTopology reconfigured %v", scaled)
+	}
+
+	recommendation, err = client.OptimizeTopologyAndApply(ctx, "optimal")
+	if err != nil || recommendation.Applied || len(scaled) != 0 {
+		t.Errorf("already optimal: applied %v, scaled %v, err %v, want nothing changed", recommendation.Applied, scaled, err)
+	}
+
+	recommendation, err = client.OptimizeTopologyAndApply(ctx, "swarm-1")
+	if err != nil || !recommendation.Applied || len(scaled) != 1 || scaled[0] != "swarm-1:hierarchical" {
+		t.Errorf("applied %v, scaled %v, err %v, want swarm-1 reconfigured to hierarchical", recommendation.Applied, scaled, err)
+	}
+
+	recommendation, err = client.OptimizeTopologyAndApply(ctx, "locked")
+	if !HasCode(err, "SWARM_BUSY") || recommendation == nil || recommendation.Applied {
+		t.Errorf("failed reconfiguration: got %+v, %v, want the recommendation with the error", recommendation, err)
+	}
+}
+
+func TestOptimizeTopologyRequiresRecommendation(t *testing.T) {
+	client := memoryClient(func(context.Context, *A2AMessage) (*A2AResponse, error) {
+		return &A2AResponse{Success: true, Result: map[string]interface{}{"current_topology": "mesh"}}, nil
+	}, nil)
+
+	if _, err := client.OptimizeTopology(context.Background(), "swarm-1"); !HasCode(err, CodeDecode) {
+		t.Errorf("got %v, want DECODE_ERROR", err)
+	}
+}
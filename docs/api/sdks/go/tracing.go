@@ -0,0 +1,138 @@
+package a2aclient
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName identifies the client's spans
+const tracerName = "github.com/gemini-flow/a2a-client-go"
+
+// traceContextPropagator injects W3C traceparent and tracestate
+var traceContextPropagator = propagation.TraceContext{}
+
+// startSpan starts a client span for a message when tracing is enabled. The
+// returned function ends it with the outcome of the send.
+func (c *A2AClient) startSpan(ctx context.Context, message *A2AMessage) (context.Context, func(*A2AResponse, error)) {
+	if c.config.TracerProvider == nil {
+		return ctx, func(*A2AResponse, error) {}
+	}
+
+	ctx, span := c.config.TracerProvider.Tracer(tracerName).Start(remoteParent(ctx), string(message.ToolName),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("a2a.message_id", message.ID),
+			attribute.String("a2a.target_type", targetType(message.Target)),
+			attribute.String("a2a.coordination_mode", coordinationMode(message.Coordination)),
+		))
+
+	return ctx, func(response *A2AResponse, err error) {
+		defer span.End()
+
+		correlationID := message.CorrelationID
+		if correlationID == "" {
+			correlationID = message.ID
+		}
+		span.SetAttributes(attribute.String("a2a.correlation_id", correlationID))
+
+		switch {
+		case err != nil:
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		case response != nil && !response.Success:
+			description := "unsuccessful response"
+			if response.Error != nil {
+				span.SetAttributes(attribute.String("a2a.error_code", response.Error.Code))
+				description = response.Error.Message
+			}
+			span.SetStatus(codes.Error, description)
+		default:
+			span.SetStatus(codes.Ok, "")
+		}
+	}
+}
+
+// recordRetry notes a retry on the current span
+func recordRetry(ctx context.Context, attempt int) {
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return
+	}
+	span.SetAttributes(attribute.Int("a2a.retry_count", attempt))
+	span.AddEvent("retry", trace.WithAttributes(attribute.Int("a2a.attempt", attempt)))
+}
+
+// injectTraceContext returns the W3C trace context of ctx as a string map:
+// that of its span when tracing is enabled, otherwise any passed through
+// with WithTraceContext. It returns nil if there is neither.
+func (c *A2AClient) injectTraceContext(ctx context.Context) map[string]string {
+	carrier := propagation.MapCarrier{}
+	if c.config.TracerProvider != nil {
+		traceContextPropagator.Inject(ctx, carrier)
+	}
+	if len(carrier) == 0 {
+		incoming, _ := ctx.Value(traceContextKey{}).(propagation.MapCarrier)
+		for key, value := range incoming {
+			carrier[key] = value
+		}
+	}
+	if len(carrier) == 0 {
+		return nil
+	}
+	return carrier
+}
+
+type traceContextKey struct{}
+
+// WithTraceContext returns a context whose requests carry the given W3C
+// traceparent and, if not empty, tracestate, e.g. those of the inbound
+// request being served, so that platforms without OpenTelemetry can still
+// correlate them. They are sent as HTTP headers and in the TraceContext of
+// WebSocket messages, and parent the client's spans when tracing is
+// enabled. An invalid traceparent is ignored.
+func WithTraceContext(ctx context.Context, traceparent, tracestate string) context.Context {
+	carrier := propagation.MapCarrier{"traceparent": traceparent}
+	if tracestate != "" {
+		carrier["tracestate"] = tracestate
+	}
+	remote := trace.SpanContextFromContext(traceContextPropagator.Extract(context.Background(), carrier))
+	if !remote.IsValid() {
+		return ctx
+	}
+	return context.WithValue(ctx, traceContextKey{}, carrier)
+}
+
+// remoteParent returns ctx with the trace context passed through with
+// WithTraceContext as its remote span, unless it already has a span
+func remoteParent(ctx context.Context) context.Context {
+	if trace.SpanContextFromContext(ctx).IsValid() {
+		return ctx
+	}
+	incoming, ok := ctx.Value(traceContextKey{}).(propagation.MapCarrier)
+	if !ok {
+		return ctx
+	}
+	return traceContextPropagator.Extract(ctx, incoming)
+}
+
+type requestIDKey struct{}
+
+// WithRequestID returns a context whose requests are sent with id as their
+// X-Request-ID instead of the message ID, e.g. to reuse the ID of the
+// inbound request being served
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// requestID returns the request ID for message: the one set on ctx with
+// WithRequestID, or else the message ID
+func requestID(ctx context.Context, message *A2AMessage) string {
+	if id, _ := ctx.Value(requestIDKey{}).(string); id != "" {
+		return id
+	}
+	return message.ID
+}
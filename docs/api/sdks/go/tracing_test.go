@@ -0,0 +1,78 @@
+package a2aclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	testTraceparent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	testTracestate  = "vendor=abc"
+)
+
+func TestRequestHeadersOnTheWire(t *testing.T) {
+	headers := make(chan http.Header, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers <- r.Header.Clone()
+		io.WriteString(w, okResponse)
+	}))
+	defer server.Close()
+	client := NewA2AClient(&A2AClientConfig{BaseURL: server.URL, RetryPolicy: fastRetries(0)})
+
+	message := directMessage(MCPToolClaudeFlowSwarmStatus, nil)
+	if _, err := client.SendMessage(context.Background(), message); err != nil {
+		t.Fatal(err)
+	}
+	h := <-headers
+	if h.Get("X-Request-ID") != message.ID {
+		t.Errorf("X-Request-ID %q, want the message ID %q", h.Get("X-Request-ID"), message.ID)
+	}
+	if h.Get("traceparent") != "" {
+		t.Errorf("traceparent %q sent without any trace context", h.Get("traceparent"))
+	}
+
+	ctx := WithTraceContext(WithRequestID(context.Background(), "inbound-1"), testTraceparent, testTracestate)
+	if _, err := client.SendMessage(ctx, directMessage(MCPToolClaudeFlowSwarmStatus, nil)); err != nil {
+		t.Fatal(err)
+	}
+	h = <-headers
+	if h.Get("X-Request-ID") != "inbound-1" || h.Get("traceparent") != testTraceparent || h.Get("tracestate") != testTracestate {
+		t.Errorf("X-Request-ID %q, traceparent %q, tracestate %q, want those on the context",
+			h.Get("X-Request-ID"), h.Get("traceparent"), h.Get("tracestate"))
+	}
+
+	// An invalid traceparent is not passed on
+	ctx = WithTraceContext(context.Background(), "00-bad", "")
+	if _, err := client.SendMessage(ctx, directMessage(MCPToolClaudeFlowSwarmStatus, nil)); err != nil {
+		t.Fatal(err)
+	}
+	if h = <-headers; h.Get("traceparent") != "" {
+		t.Errorf("invalid traceparent sent as %q", h.Get("traceparent"))
+	}
+}
+
+func TestTraceContextInWebSocketMessages(t *testing.T) {
+	received := make(chan *A2AMessage, 1)
+	server := newWSServer(t, func(_ *websocket.Conn, message *A2AMessage) *A2AResponse {
+		received <- message
+		return echoResult(message)
+	})
+	client := connectWS(t, server, nil)
+
+	ctx := WithTraceContext(WithRequestID(context.Background(), "inbound-1"), testTraceparent, "")
+	if _, err := client.SendMessage(ctx, directMessage(MCPToolClaudeFlowSwarmStatus, nil)); err != nil {
+		t.Fatal(err)
+	}
+	message := <-received
+	if message.RequestID != "inbound-1" || message.TraceContext["traceparent"] != testTraceparent {
+		t.Errorf("request ID %q, trace context %v, want those on the context", message.RequestID, message.TraceContext)
+	}
+	if _, ok := message.TraceContext["tracestate"]; ok {
+		t.Errorf("trace context %v, want no empty tracestate", message.TraceContext)
+	}
+}
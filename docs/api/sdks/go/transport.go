@@ -0,0 +1,115 @@
+package a2aclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Transport carries messages to the A2A service. By default the client sends
+// over its pooled WebSocket connections while connected and over HTTP
+// otherwise; set A2AClientConfig.Transport to use another backend, such as
+// gRPC, or MemoryTransport in tests. Rate limiting, retries, the circuit
+// breaker and logging are applied by the client around the transport.
+type Transport interface {
+	// Send delivers a message and returns its response
+	Send(ctx context.Context, message *A2AMessage) (*A2AResponse, error)
+}
+
+// StreamingTransport is a Transport that can also deliver a series of
+// responses to one message, as used by SendMessageStream
+type StreamingTransport interface {
+	Transport
+	// Stream sends a message and forwards every response to it until one is
+	// Final, the stream ends or ctx is done. onDone is called with the
+	// stream's terminal error (nil on completion) after the returned channel
+	// is closed.
+	Stream(ctx context.Context, message *A2AMessage, onDone func(error)) (<-chan *A2AResponse, error)
+}
+
+// defaultTransport sends over a pooled WebSocket connection when one is open
+// and over HTTP otherwise
+type defaultTransport struct {
+	client *A2AClient
+}
+
+func (t defaultTransport) Send(ctx context.Context, message *A2AMessage) (*A2AResponse, error) {
+	if link := t.client.nextLink(); link != nil {
+		return t.client.sendViaWebSocket(ctx, link, message)
+	}
+	return t.client.sendViaHTTP(ctx, message)
+}
+
+func (t defaultTransport) Stream(ctx context.Context, message *A2AMessage, onDone func(error)) (<-chan *A2AResponse, error) {
+	if t.client.nextLink() != nil {
+		responses, _, err := t.client.openStream(ctx, message, onDone)
+		return responses, err
+	}
+	if t.client.fallingBack() {
+		return nil, NewA2AClientError(CodeWebSocketRequired, "WebSocket is down; streams do not fall back to HTTP", nil)
+	}
+	return t.client.openEventStream(ctx, message, onDone)
+}
+
+// fallingBack reports whether sends are going over HTTP because
+// FallbackToHTTP is set and the WebSocket is down
+func (c *A2AClient) fallingBack() bool {
+	if !c.config.WebSocketEnabled || !c.config.FallbackToHTTP {
+		return false
+	}
+	c.connectionMux.RLock()
+	defer c.connectionMux.RUnlock()
+	return c.connectionLost
+}
+
+// MemoryTransport is an in-process Transport that hands each message to
+// Handler instead of a server, for tests and for services embedded in the
+// same process. Messages pass through a JSON round trip, so Handler sees
+// what a server would receive.
+type MemoryTransport struct {
+	// Handler answers each message. If nil, every message succeeds with an
+	// empty result.
+	Handler func(ctx context.Context, message *A2AMessage) (*A2AResponse, error)
+
+	mu   sync.Mutex
+	sent []*A2AMessage
+}
+
+// Send records the message and returns Handler's response
+func (t *MemoryTransport) Send(ctx context.Context, message *A2AMessage) (*A2AResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal message: %w", err)
+	}
+	var received A2AMessage
+	if err := json.Unmarshal(data, &received); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal message: %w", err)
+	}
+
+	t.mu.Lock()
+	t.sent = append(t.sent, &received)
+	t.mu.Unlock()
+
+	if t.Handler != nil {
+		return t.Handler(ctx, &received)
+	}
+	return &A2AResponse{
+		MessageID:     received.ID,
+		CorrelationID: received.CorrelationID,
+		Success:       true,
+		Timestamp:     time.Now().Unix(),
+	}, nil
+}
+
+// Sent returns the messages sent so far, in order
+func (t *MemoryTransport) Sent() []*A2AMessage {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]*A2AMessage(nil), t.sent...)
+}
@@ -0,0 +1,98 @@
+package a2aclient
+
+import (
+	"context"
+	"time"
+)
+
+// TriggerEvent is a single firing of a server-side trigger
+type TriggerEvent struct {
+	TriggerID string
+	FiredAt   time.Time
+	Payload   interface{}
+}
+
+// SubscribeTriggers streams the events fired by a trigger created with
+// trigger_setup. The subscription is restored after reconnects; call the
+// returned function or cancel ctx to end it, which closes the channel.
+func (c *A2AClient) SubscribeTriggers(ctx context.Context, triggerID string) (<-chan TriggerEvent, func(), error) {
+	message := &A2AMessage{
+		Target: AgentTarget{
+			GroupTarget: &GroupTarget{
+				Type:      "group",
+				Role:      AgentRoleCoordinator,
+				MaxAgents: intPtr(1),
+			},
+		},
+		ToolName: MCPToolClaudeFlowTriggerSetup,
+		Parameters: map[string]interface{}{
+			"action":    "subscribe",
+			"triggerId": triggerID,
+		},
+		Coordination: CoordinationMode{
+			DirectCoordination: &DirectCoordination{
+				Mode: "direct",
+			},
+		},
+	}
+
+	subCtx, cancel := context.WithCancel(ctx)
+	responses, _, err := c.subscribe(subCtx, message)
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+
+	events := make(chan TriggerEvent)
+	go func() {
+		defer close(events)
+		for response := range responses {
+			event, ok := decodeTriggerEvent(response, triggerID)
+			if !ok {
+				continue
+			}
+			select {
+			case events <- event:
+			case <-subCtx.Done():
+				// Drain until the subscription goroutine sees the cancellation
+				for range responses {
+				}
+				return
+			}
+		}
+	}()
+
+	return events, cancel, nil
+}
+
+// decodeTriggerEvent decodes a pushed trigger firing. Responses without a
+// fired_at time, such as the subscription acknowledgement, or failed
+// responses are not events.
+func decodeTriggerEvent(response *A2AResponse, triggerID string) (TriggerEvent, bool) {
+	if !response.Success {
+		return TriggerEvent{}, false
+	}
+
+	var result struct {
+		TriggerID string      `json:"trigger_id"`
+		FiredAt   interface{} `json:"fired_at"`
+		Payload   interface{} `json:"payload"`
+	}
+	if err := decodeMap(response.Result, &result); err != nil {
+		return TriggerEvent{}, false
+	}
+
+	firedAt, ok := parseTimestamp(result.FiredAt)
+	if !ok {
+		return TriggerEvent{}, false
+	}
+
+	if result.TriggerID == "" {
+		result.TriggerID = triggerID
+	}
+	return TriggerEvent{
+		TriggerID: result.TriggerID,
+		FiredAt:   firedAt,
+		Payload:   result.Payload,
+	}, true
+}
@@ -0,0 +1,47 @@
+package a2aclient
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// stampCreated records when the message was first passed to SendMessage,
+// the moment its TTL counts from. Later sends of the same message keep the
+// original stamp.
+func stampCreated(message *A2AMessage) {
+	if message.createdAt.IsZero() {
+		message.createdAt = time.Now()
+	}
+}
+
+// expiresAt returns when the message's TTL runs out, or the zero time if it
+// has no TTL or has not been sent yet
+func (m *A2AMessage) expiresAt() time.Time {
+	if m.TTL == nil || m.createdAt.IsZero() {
+		return time.Time{}
+	}
+	return m.createdAt.Add(time.Duration(*m.TTL) * time.Second)
+}
+
+// checkExpired returns a MESSAGE_EXPIRED error if the message's TTL has run
+// out, so that it is not sent
+func checkExpired(message *A2AMessage) error {
+	deadline := message.expiresAt()
+	if deadline.IsZero() || time.Now().Before(deadline) {
+		return nil
+	}
+	return NewA2AClientError(CodeMessageExpired,
+		fmt.Sprintf("Message TTL of %ds expired before it was sent", *message.TTL), message.ID)
+}
+
+// refreshTTL returns a copy of a message that waited before being sent,
+// with its TTL set to the time it has left so the server counts it from
+// receipt. The caller's message keeps its original TTL and creation time.
+func refreshTTL(message *A2AMessage, deadline time.Time) *A2AMessage {
+	ttl := int(math.Ceil(time.Until(deadline).Seconds()))
+	refreshed := *message
+	refreshed.TTL = &ttl
+	refreshed.createdAt = time.Now()
+	return &refreshed
+}
@@ -0,0 +1,83 @@
+package a2aclient
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestOfflineFlushKeepsCallerTTL(t *testing.T) {
+	sent := make(chan int, 1)
+	client := memoryClient(func(_ context.Context, message *A2AMessage) (*A2AResponse, error) {
+		sent <- *message.TTL
+		return &A2AResponse{Success: true}, nil
+	}, func(config *A2AClientConfig) {
+		config.QueueWhenOffline = true
+	})
+
+	message := directMessage(MCPToolClaudeFlowAgentSpawn, nil)
+	message.TTL = intPtr(60)
+	if _, err := client.SendMessage(context.Background(), message); !errors.Is(err, ErrQueued) {
+		t.Fatalf("got %v, want ErrQueued", err)
+	}
+	created := message.createdAt.Add(-30 * time.Second)
+	message.createdAt = created
+
+	client.flushOfflineQueue()
+	if ttl := <-sent; ttl > 30 {
+		t.Errorf("server saw TTL %d, want the 30s or less left", ttl)
+	}
+	if *message.TTL != 60 || !message.createdAt.Equal(created) {
+		t.Errorf("caller's message changed to TTL %d created %v", *message.TTL, message.createdAt)
+	}
+}
+
+func TestMessageExpiresDuringBackoff(t *testing.T) {
+	var sends int32
+	client := memoryClient(func(context.Context, *A2AMessage) (*A2AResponse, error) {
+		atomic.AddInt32(&sends, 1)
+		return nil, NewA2AClientError(CodeConnectionFailed, "refused", nil)
+	}, func(config *A2AClientConfig) {
+		// The first retry waits out the one-second TTL
+		config.RetryPolicy = fastRetries(3)
+		config.RetryPolicy.BaseDelay = 1100 * time.Millisecond
+		config.RetryPolicy.MaxDelay = 1100 * time.Millisecond
+	})
+
+	message := directMessage(MCPToolClaudeFlowAgentSpawn, nil)
+	message.TTL = intPtr(1)
+	if _, err := client.SendMessage(context.Background(), message); !HasCode(err, CodeMessageExpired) {
+		t.Fatalf("got %v, want MESSAGE_EXPIRED", err)
+	}
+	if sends != 1 {
+		t.Errorf("server saw %d sends, want only the one before the TTL ran out", sends)
+	}
+}
+
+func TestMessageExpiresWhileQueuedOffline(t *testing.T) {
+	results := make(chan error, 1)
+	client := memoryClient(func(context.Context, *A2AMessage) (*A2AResponse, error) {
+		t.Error("expired message was sent")
+		return &A2AResponse{Success: true}, nil
+	}, func(config *A2AClientConfig) {
+		config.QueueWhenOffline = true
+		config.OnQueuedResult = func(_ *A2AMessage, _ *A2AResponse, err error) {
+			results <- err
+		}
+	})
+
+	message := directMessage(MCPToolClaudeFlowAgentSpawn, nil)
+	message.TTL = intPtr(60)
+	if _, err := client.SendMessage(context.Background(), message); !errors.Is(err, ErrQueued) {
+		t.Fatalf("got %v, want ErrQueued", err)
+	}
+	// Offline for longer than the TTL
+	message.createdAt = message.createdAt.Add(-time.Minute)
+
+	client.flushOfflineQueue()
+	if err := <-results; !HasCode(err, CodeMessageExpired) {
+		t.Errorf("queued message got %v, want MESSAGE_EXPIRED", err)
+	}
+}
@@ -0,0 +1,132 @@
+package a2aclient
+
+import (
+	"strings"
+	"testing"
+)
+
+// checkValidation runs ValidateMessage on a direct message after mutate and
+// expects no problems if want is empty, or exactly one mentioning want
+func checkValidation(t *testing.T, mutate func(message *A2AMessage), want string) {
+	t.Helper()
+	message := directMessage(MCPToolClaudeFlowSwarmStatus, nil)
+	mutate(message)
+	problems := Utils.ValidateMessage(message)
+	if want == "" {
+		if len(problems) != 0 {
+			t.Errorf("got %q, want a valid message", problems)
+		}
+		return
+	}
+	if len(problems) != 1 || !strings.Contains(problems[0], want) {
+		t.Errorf("got %q, want one problem mentioning %q", problems, want)
+	}
+}
+
+func TestValidateMessageCoordinationValues(t *testing.T) {
+	consensus := func(configure func(*ConsensusCoordination)) func(*A2AMessage) {
+		return func(message *A2AMessage) {
+			c := &ConsensusCoordination{Mode: "consensus", ConsensusType: "majority"}
+			configure(c)
+			message.Coordination = CoordinationMode{ConsensusCoordination: c}
+		}
+	}
+	broadcast := func(aggregation string, timeout *int) func(*A2AMessage) {
+		return func(message *A2AMessage) {
+			message.Coordination = CoordinationMode{BroadcastCoordination: &BroadcastCoordination{
+				Mode: "broadcast", Aggregation: aggregation, Timeout: timeout,
+			}}
+		}
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(*A2AMessage)
+		want   string
+	}{
+		{"valid direct", func(m *A2AMessage) {
+			m.Coordination.DirectCoordination.Timeout = intPtr(30)
+			m.Coordination.DirectCoordination.Retries = intPtr(0)
+		}, ""},
+		{"valid broadcast", broadcast("first", intPtr(10)), ""},
+		{"valid consensus", consensus(func(c *ConsensusCoordination) {
+			c.ConsensusType = "weighted"
+			c.VotingTimeout = intPtr(60)
+			c.MinimumParticipants = intPtr(maxConsensusParticipants)
+		}), ""},
+		{"zero direct timeout", func(m *A2AMessage) { m.Coordination.DirectCoordination.Timeout = intPtr(0) }, "Direct coordination timeout must be positive"},
+		{"negative direct retries", func(m *A2AMessage) { m.Coordination.DirectCoordination.Retries = intPtr(-1) }, "retries must not be negative"},
+		{"unknown aggregation", broadcast("sum", nil), `got "sum"`},
+		{"empty aggregation", broadcast("", nil), "Broadcast aggregation must be"},
+		{"negative broadcast timeout", broadcast("all", intPtr(-5)), "Broadcast coordination timeout must be positive"},
+		{"unknown consensus type", consensus(func(c *ConsensusCoordination) { c.ConsensusType = "plurality" }), `got "plurality"`},
+		{"zero voting timeout", consensus(func(c *ConsensusCoordination) { c.VotingTimeout = intPtr(0) }), "voting timeout must be positive"},
+		{"zero participants", consensus(func(c *ConsensusCoordination) { c.MinimumParticipants = intPtr(0) }), "minimum participants must be positive"},
+		{"too many participants", consensus(func(c *ConsensusCoordination) {
+			c.MinimumParticipants = intPtr(maxConsensusParticipants + 1)
+		}), "must be at most 1000"},
+		{"zero stage timeout", func(m *A2AMessage) {
+			m.Coordination = CoordinationMode{PipelineCoordination: &PipelineCoordination{
+				Mode:   "pipeline",
+				Stages: []PipelineStage{{Name: "a", ToolName: "a"}, {Name: "b", ToolName: "b", Timeout: intPtr(0)}},
+			}}
+		}, "Pipeline stage 1 timeout must be positive"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkValidation(t, tt.mutate, tt.want)
+		})
+	}
+}
+
+func TestValidateMessageRequirements(t *testing.T) {
+	resource := func(typ string, amount float64, unit string) func(*A2AMessage) {
+		return func(message *A2AMessage) {
+			message.ResourceRequirements = []ResourceRequirement{{Type: typ, Amount: amount, Unit: unit}}
+		}
+	}
+	state := func(typ, consistency string, keys ...string) func(*A2AMessage) {
+		return func(message *A2AMessage) {
+			message.StateRequirements = []StateRequirement{{Type: typ, Namespace: "ns", Keys: keys, Consistency: consistency}}
+		}
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(*A2AMessage)
+		want   string
+	}{
+		{"valid resource", resource("gpu", 1, "devices"), ""},
+		{"valid custom resource", resource("custom", 0.5, "licenses"), ""},
+		{"unknown resource type", resource("bandwidth", 1, "mbps"), `Resource requirement 0 has unknown type "bandwidth"`},
+		{"zero amount", resource("cpu", 0, "cores"), "Resource requirement 0 amount must be positive"},
+		{"negative amount", resource("memory", -1, "GB"), "amount must be positive"},
+		{"no unit", resource("storage", 10, ""), "Resource requirement 0 requires a unit"},
+
+		{"valid read", state("read", "strong", "k"), ""},
+		{"valid write", state("write", "causal", "k1", "k2"), ""},
+		{"exclusive without keys", state("exclusive", "eventual"), ""},
+		{"shared without keys", state("shared", "strong"), ""},
+		{"read without keys", state("read", "eventual"), "State requirement 0 of type read requires at least one key"},
+		{"write without keys", state("write", "strong"), "of type write requires at least one key"},
+		{"unknown state type", state("append", "strong", "k"), `State requirement 0 has unknown type "append"`},
+		{"unknown consistency", state("read", "linearizable", "k"), `unknown consistency "linearizable"`},
+		{"empty consistency", state("shared", ""), `unknown consistency ""`},
+		{"second requirement", func(m *A2AMessage) {
+			m.ResourceRequirements = []ResourceRequirement{{Type: "cpu", Amount: 1, Unit: "cores"}, {Type: "cpu", Amount: 1}}
+		}, "Resource requirement 1 requires a unit"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkValidation(t, tt.mutate, tt.want)
+		})
+	}
+
+	// Every problem with a requirement is reported in the same pass
+	message := directMessage(MCPToolClaudeFlowSwarmStatus, nil)
+	message.ResourceRequirements = []ResourceRequirement{{Type: "bandwidth"}}
+	message.StateRequirements = []StateRequirement{{Type: "read"}}
+	if problems := Utils.ValidateMessage(message); len(problems) != 5 {
+		t.Errorf("got %q, want three resource and two state problems", problems)
+	}
+}
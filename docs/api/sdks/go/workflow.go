@@ -0,0 +1,116 @@
+package a2aclient
+
+import (
+	"context"
+	"fmt"
+)
+
+// WorkflowDefinition describes a workflow as a graph of nodes
+type WorkflowDefinition struct {
+	Name     string                 `json:"name"`
+	Nodes    []WorkflowNode         `json:"nodes"`
+	Edges    []WorkflowEdge         `json:"edges,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// WorkflowNode is a step of a workflow, run by invoking a tool
+type WorkflowNode struct {
+	ID          string                 `json:"id"`
+	ToolName    MCPToolName            `json:"tool_name"`
+	Parameters  map[string]interface{} `json:"parameters,omitempty"`
+	AgentTarget *AgentTarget           `json:"agent_target,omitempty"`
+}
+
+// WorkflowEdge makes the To node run after the From node, optionally only
+// when Condition holds
+type WorkflowEdge struct {
+	From      string `json:"from"`
+	To        string `json:"to"`
+	Condition string `json:"condition,omitempty"`
+}
+
+// Validate checks that the definition has at least one node, that node IDs
+// are unique and that every edge connects known nodes
+func (d WorkflowDefinition) Validate() error {
+	if len(d.Nodes) == 0 {
+		return NewA2AClientError(CodeValidation, "Workflow must have at least one node", nil)
+	}
+	ids := make(map[string]bool, len(d.Nodes))
+	for _, node := range d.Nodes {
+		if node.ID == "" {
+			return NewA2AClientError(CodeValidation, "Workflow node ID is required", nil)
+		}
+		if ids[node.ID] {
+			return NewA2AClientError(CodeValidation, fmt.Sprintf("Duplicate workflow node ID %q", node.ID), node.ID)
+		}
+		ids[node.ID] = true
+	}
+	for _, edge := range d.Edges {
+		if !ids[edge.From] || !ids[edge.To] {
+			return NewA2AClientError(CodeValidation, fmt.Sprintf("Workflow edge %s -> %s references an unknown node", edge.From, edge.To), edge)
+		}
+	}
+	return nil
+}
+
+// CreateWorkflow registers a workflow definition. The workflow ID is in the
+// response's result.
+func (c *A2AClient) CreateWorkflow(ctx context.Context, def WorkflowDefinition) (*A2AResponse, error) {
+	if err := def.Validate(); err != nil {
+		return nil, err
+	}
+	return c.SendMessage(ctx, workflowMessage(MCPToolClaudeFlowWorkflowCreate, map[string]interface{}{
+		"name":     def.Name,
+		"nodes":    def.Nodes,
+		"edges":    def.Edges,
+		"metadata": def.Metadata,
+	}))
+}
+
+// ExecuteWorkflow runs a created workflow with the given inputs
+func (c *A2AClient) ExecuteWorkflow(ctx context.Context, workflowID string, inputs map[string]interface{}) (*A2AResponse, error) {
+	if workflowID == "" {
+		return nil, NewA2AClientError(CodeValidation, "Workflow ID is required", nil)
+	}
+	return c.SendMessage(ctx, workflowMessage(MCPToolClaudeFlowWorkflowExecute, map[string]interface{}{
+		"workflowId": workflowID,
+		"params":     inputs,
+	}))
+}
+
+// ExportWorkflow exports a workflow definition in the given format, such as
+// "json" (the default) or "yaml"
+func (c *A2AClient) ExportWorkflow(ctx context.Context, workflowID, format string) (*A2AResponse, error) {
+	if workflowID == "" {
+		return nil, NewA2AClientError(CodeValidation, "Workflow ID is required", nil)
+	}
+	if format == "" {
+		format = "json"
+	}
+	return c.SendMessage(ctx, workflowMessage(MCPToolClaudeFlowWorkflowExport, map[string]interface{}{
+		"workflowId": workflowID,
+		"format":     format,
+	}))
+}
+
+// workflowMessage builds a message for a workflow tool, handled by a task
+// orchestrator
+func workflowMessage(toolName MCPToolName, params map[string]interface{}) *A2AMessage {
+	return &A2AMessage{
+		Target: AgentTarget{
+			GroupTarget: &GroupTarget{
+				Type:              "group",
+				Role:              AgentRoleTaskOrchestrator,
+				MaxAgents:         intPtr(1),
+				SelectionStrategy: "load-balanced",
+			},
+		},
+		ToolName:   toolName,
+		Parameters: params,
+		Coordination: CoordinationMode{
+			DirectCoordination: &DirectCoordination{
+				Mode: "direct",
+			},
+		},
+	}
+}
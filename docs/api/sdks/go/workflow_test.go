@@ -0,0 +1,81 @@
+package a2aclient
+
+import (
+	"context"
+	"testing"
+)
+
+func TestWorkflowDefinitionValidate(t *testing.T) {
+	node := func(id string) WorkflowNode {
+		return WorkflowNode{ID: id, ToolName: MCPToolClaudeFlowTaskOrchestrate}
+	}
+	tests := []struct {
+		name  string
+		def   WorkflowDefinition
+		valid bool
+	}{
+		{"no nodes", WorkflowDefinition{Name: "w"}, false},
+		{"missing ID", WorkflowDefinition{Nodes: []WorkflowNode{node("")}}, false},
+		{"duplicate ID", WorkflowDefinition{Nodes: []WorkflowNode{node("a"), node("a")}}, false},
+		{"unknown edge node", WorkflowDefinition{Nodes: []WorkflowNode{node("a")}, Edges: []WorkflowEdge{{From: "a", To: "b"}}}, false},
+		{"valid", WorkflowDefinition{Nodes: []WorkflowNode{node("a"), node("b")}, Edges: []WorkflowEdge{{From: "a", To: "b", Condition: "ok"}}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.def.Validate()
+			if tt.valid && err != nil {
+				t.Errorf("Validate: %v", err)
+			}
+			if !tt.valid && !HasCode(err, CodeValidation) {
+				t.Errorf("got %v, want VALIDATION_ERROR", err)
+			}
+		})
+	}
+}
+
+func TestWorkflowHelpers(t *testing.T) {
+	var sent []*A2AMessage
+	client := memoryClient(func(_ context.Context, message *A2AMessage) (*A2AResponse, error) {
+		sent = append(sent, message)
+		return echoResult(message), nil
+	}, nil)
+	ctx := context.Background()
+
+	if _, err := client.CreateWorkflow(ctx, WorkflowDefinition{Name: "empty"}); !HasCode(err, CodeValidation) {
+		t.Errorf("CreateWorkflow with no nodes: got %v, want VALIDATION_ERROR", err)
+	}
+	if _, err := client.ExecuteWorkflow(ctx, "", nil); !HasCode(err, CodeValidation) {
+		t.Errorf("ExecuteWorkflow without an ID: got %v, want VALIDATION_ERROR", err)
+	}
+	if len(sent) != 0 {
+		t.Fatalf("%d invalid requests were sent", len(sent))
+	}
+
+	def := WorkflowDefinition{Name: "w", Nodes: []WorkflowNode{{ID: "a", ToolName: MCPToolClaudeFlowTaskOrchestrate}}}
+	if _, err := client.CreateWorkflow(ctx, def); err != nil {
+		t.Fatalf("CreateWorkflow: %v", err)
+	}
+	if _, err := client.ExecuteWorkflow(ctx, "wf-1", map[string]interface{}{"input": "x"}); err != nil {
+		t.Fatalf("ExecuteWorkflow: %v", err)
+	}
+	if _, err := client.ExportWorkflow(ctx, "wf-1", ""); err != nil {
+		t.Fatalf("ExportWorkflow: %v", err)
+	}
+
+	create, execute, export := sent[0], sent[1], sent[2]
+	if create.ToolName != MCPToolClaudeFlowWorkflowCreate || create.Parameters["nodes"].([]interface{})[0].(map[string]interface{})["id"] != "a" {
+		t.Errorf("create sent %s %v", create.ToolName, create.Parameters)
+	}
+	if execute.ToolName != MCPToolClaudeFlowWorkflowExecute || execute.Parameters["workflowId"] != "wf-1" ||
+		execute.Parameters["params"].(map[string]interface{})["input"] != "x" {
+		t.Errorf("execute sent %s %v", execute.ToolName, execute.Parameters)
+	}
+	if export.ToolName != MCPToolClaudeFlowWorkflowExport || export.Parameters["format"] != "json" {
+		t.Errorf("export sent %s %v, want the json default", export.ToolName, export.Parameters)
+	}
+	for _, message := range sent {
+		if message.Target.GroupTarget == nil || message.Target.GroupTarget.Role != AgentRoleTaskOrchestrator {
+			t.Errorf("%s sent to %+v, want a task orchestrator", message.ToolName, message.Target)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package a2aclient
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// wsLink is one WebSocket connection of the client's pool
+type wsLink struct {
+	index int
+	conn  *websocket.Conn
+	done  chan struct{} // closed when the connection's reader stops
+	// writeMutex serializes writes; gorilla connections support only one
+	// concurrent writer
+	writeMutex   sync.Mutex
+	lastActivity atomic.Int64 // unix nanoseconds of the last frame read
+	// compressAbove is the frame size above which frames are compressed if
+	// permessage-deflate was negotiated; zero disables compression
+	compressAbove int
+}
+
+// write writes a frame to the connection. Every WebSocket write must go
+// through here.
+func (l *wsLink) write(messageType int, data []byte) error {
+	l.writeMutex.Lock()
+	defer l.writeMutex.Unlock()
+	l.conn.EnableWriteCompression(l.compressAbove > 0 && len(data) > l.compressAbove)
+	return l.conn.WriteMessage(messageType, data)
+}
+
+// alive reports whether the connection's reader is still running
+func (l *wsLink) alive() bool {
+	select {
+	case <-l.done:
+		return false
+	default:
+		return true
+	}
+}
+
+// nextLink returns the next connected link of the pool in round-robin order,
+// or nil if none is connected
+func (c *A2AClient) nextLink() *wsLink {
+	c.connectionMux.RLock()
+	defer c.connectionMux.RUnlock()
+
+	n := len(c.wsLinks)
+	start := int(atomic.AddUint32(&c.nextLinkIndex, 1))
+	for i := 0; i < n; i++ {
+		if link := c.wsLinks[(start+i)%n]; link != nil {
+			return link
+		}
+	}
+	return nil
+}
+
+// ConnectionHealth describes one connection of the WebSocket pool
+type ConnectionHealth struct {
+	Index        int
+	Connected    bool
+	LastActivity time.Time // when a frame was last read; zero if never
+}
+
+// PoolHealth reports the state of every connection in the WebSocket pool,
+// in pool order
+func (c *A2AClient) PoolHealth() []ConnectionHealth {
+	c.connectionMux.RLock()
+	defer c.connectionMux.RUnlock()
+
+	health := make([]ConnectionHealth, len(c.wsLinks))
+	for i, link := range c.wsLinks {
+		health[i].Index = i
+		if link == nil {
+			continue
+		}
+		health[i].Connected = true
+		if nanos := link.lastActivity.Load(); nanos != 0 {
+			health[i].LastActivity = time.Unix(0, nanos)
+		}
+	}
+	return health
+}
+
+// reconnectLink re-dials a single dropped connection of the pool with the
+// reconnect policy's backoff while the other connections keep serving. It
+// stops when the attempts run out, the slot is filled by someone else, or
+// the client disconnects or loses every connection, which reconnect handles.
+func (c *A2AClient) reconnectLink(index int) {
+	policy := c.config.ReconnectPolicy
+	backoff := &RetryPolicy{
+		BackoffStrategy: policy.BackoffStrategy,
+		BaseDelay:       policy.BaseDelay,
+		MaxDelay:        policy.MaxDelay,
+	}
+	waiting := func() bool { return c.linkVacant(index) }
+
+	for attempt := 0; attempt < policy.MaxAttempts; attempt++ {
+		if !c.waitBackoff(backoffDelay(backoff, attempt), waiting) {
+			return
+		}
+
+		c.emitConnectionEvent(ConnectionEvent{Type: ConnectionEventAttempt, Attempt: attempt + 1, Link: index})
+		ctx, cancel := context.WithTimeout(context.Background(), c.config.Timeout)
+		link, err := c.connectWebSocket(ctx, index)
+		cancel()
+		if err != nil {
+			c.emitConnectionEvent(ConnectionEvent{Type: ConnectionEventFailed, Attempt: attempt + 1, Err: err, Link: index})
+			continue
+		}
+
+		c.connectionMux.Lock()
+		if !c.connected || c.wsLinks[index] != nil {
+			c.connectionMux.Unlock()
+			link.conn.Close()
+			return
+		}
+		c.wsLinks[index] = link
+		c.startLink(link)
+		c.connectionMux.Unlock()
+
+		c.emitConnectionEvent(ConnectionEvent{Type: ConnectionEventConnected, Attempt: attempt + 1, Link: index})
+		go c.restoreSubscriptions(link)
+		return
+	}
+}
+
+// pooled reports whether the link is still one of the pool's connections
+func (c *A2AClient) pooled(link *wsLink) bool {
+	c.connectionMux.RLock()
+	defer c.connectionMux.RUnlock()
+	return c.wsLinks[link.index] == link
+}
+
+// linkVacant reports whether the pool slot is still waiting for a
+// connection while the client as a whole is connected
+func (c *A2AClient) linkVacant(index int) bool {
+	c.connectionMux.RLock()
+	defer c.connectionMux.RUnlock()
+	return c.connected && c.wsLinks[index] == nil
+}
@@ -0,0 +1,77 @@
+package a2aclient
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// BenchmarkWebSocketPool compares concurrent sends over a single connection
+// with sends spread over a pool
+func BenchmarkWebSocketPool(b *testing.B) {
+	for _, size := range []int{1, 4} {
+		b.Run(fmt.Sprintf("connections=%d", size), func(b *testing.B) {
+			server := newWSServer(b, func(_ *websocket.Conn, message *A2AMessage) *A2AResponse {
+				return echoResult(message)
+			})
+			client := connectWS(b, server, func(config *A2AClientConfig) {
+				config.WebSocketPoolSize = size
+			})
+			params := map[string]interface{}{"payload": "benchmark"}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					if _, err := client.SendMessage(context.Background(), directMessage(MCPToolClaudeFlowSwarmStatus, params)); err != nil {
+						b.Error(err)
+						return
+					}
+				}
+			})
+		})
+	}
+}
+
+func TestDisconnectInterruptsLinkBackoff(t *testing.T) {
+	server := newWSServer(t, func(_ *websocket.Conn, message *A2AMessage) *A2AResponse {
+		return echoResult(message)
+	})
+	client := connectWS(t, server, func(config *A2AClientConfig) {
+		config.WebSocketPoolSize = 2
+		config.ReconnectEnabled = true
+		config.ReconnectPolicy = &ReconnectPolicy{MaxAttempts: 3, BackoffStrategy: "linear", BaseDelay: time.Hour, MaxDelay: time.Hour}
+	})
+
+	// Drop one connection of the pool
+	server.mu.Lock()
+	server.conns[0].Close()
+	server.mu.Unlock()
+	vacant := -1
+	for deadline := time.Now().Add(5 * time.Second); vacant < 0; time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("client did not notice the dropped socket")
+		}
+		for index := 0; index < 2; index++ {
+			if client.linkVacant(index) {
+				vacant = index
+			}
+		}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		client.reconnectLink(vacant)
+		close(done)
+	}()
+	client.Disconnect()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("reconnectLink kept sleeping after Disconnect")
+	}
+}
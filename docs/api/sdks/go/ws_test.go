@@ -0,0 +1,159 @@
+package a2aclient
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestConcurrentSendsOverOneWebSocket(t *testing.T) {
+	server := newWSServer(t, func(_ *websocket.Conn, message *A2AMessage) *A2AResponse {
+		return echoResult(message)
+	})
+	client := connectWS(t, server, nil)
+
+	const senders = 100
+	var wg sync.WaitGroup
+	for i := 0; i < senders; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					t.Errorf("send %d panicked: %v", i, r)
+				}
+			}()
+
+			want := fmt.Sprintf("payload-%d", i)
+			response, err := client.SendMessage(context.Background(),
+				directMessage(MCPToolClaudeFlowSwarmStatus, map[string]interface{}{"n": want}))
+			if err != nil {
+				t.Errorf("send %d: %v", i, err)
+				return
+			}
+			result, _ := response.Result.(map[string]interface{})
+			if result["n"] != want {
+				t.Errorf("send %d got result %v, want n=%s", i, response.Result, want)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if got := server.connections(); got != 1 {
+		t.Errorf("server accepted %d connections, want 1", got)
+	}
+}
+
+func TestWebSocketRoutesByMessageAndCorrelationID(t *testing.T) {
+	tests := []struct {
+		name  string
+		reply func(message *A2AMessage) *A2AResponse
+	}{
+		{"both IDs", echoResult},
+		{"message ID only", func(message *A2AMessage) *A2AResponse {
+			response := echoResult(message)
+			response.CorrelationID = ""
+			return response
+		}},
+		{"correlation ID only", func(message *A2AMessage) *A2AResponse {
+			response := echoResult(message)
+			response.MessageID = "server-generated"
+			return response
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newWSServer(t, func(_ *websocket.Conn, message *A2AMessage) *A2AResponse {
+				return tt.reply(message)
+			})
+			client := connectWS(t, server, nil)
+
+			message := directMessage(MCPToolClaudeFlowSwarmStatus, map[string]interface{}{"n": "x"})
+			message.CorrelationID = "group-1"
+			response, err := client.SendMessage(context.Background(), message)
+			if err != nil {
+				t.Fatalf("SendMessage: %v", err)
+			}
+			if result, _ := response.Result.(map[string]interface{}); result["n"] != "x" {
+				t.Errorf("got result %v", response.Result)
+			}
+			if n := client.pendingRequests(); n != 0 {
+				t.Errorf("%d requests still pending", n)
+			}
+		})
+	}
+}
+
+func TestWebSocketSharedCorrelationID(t *testing.T) {
+	// Hold every reply until both requests are pending
+	const senders = 2
+	arrived := make(chan struct{}, senders)
+	release := make(chan struct{})
+	server := newWSServer(t, func(_ *websocket.Conn, message *A2AMessage) *A2AResponse {
+		arrived <- struct{}{}
+		<-release
+		return echoResult(message)
+	})
+	client := connectWS(t, server, nil)
+
+	var wg sync.WaitGroup
+	for i := 0; i < senders; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			message := directMessage(MCPToolClaudeFlowSwarmStatus, map[string]interface{}{"n": fmt.Sprint(i)})
+			message.CorrelationID = "shared"
+			response, err := client.SendMessage(context.Background(), message)
+			if err != nil {
+				t.Errorf("send %d: %v", i, err)
+				return
+			}
+			if result, _ := response.Result.(map[string]interface{}); result["n"] != fmt.Sprint(i) {
+				t.Errorf("send %d got result %v", i, response.Result)
+			}
+		}(i)
+	}
+	for i := 0; i < senders; i++ {
+		<-arrived
+	}
+	close(release)
+	wg.Wait()
+
+	if n := client.pendingRequests(); n != 0 {
+		t.Errorf("%d requests still pending", n)
+	}
+}
+
+func TestRegisterPendingKeepsOtherEntries(t *testing.T) {
+	client := NewA2AClient(&A2AClientConfig{BaseURL: "http://a2a.test"})
+
+	first := make(chan *A2AResponse, 1)
+	unregisterFirst, err := client.registerPending("m1", "shared", first, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.registerPending("m1", "", make(chan *A2AResponse, 1), time.Time{}); !HasCode(err, CodeValidation) {
+		t.Errorf("duplicate message ID: got %v, want VALIDATION_ERROR", err)
+	}
+	second := make(chan *A2AResponse, 1)
+	unregisterSecond, err := client.registerPending("m2", "shared", second, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unregisterFirst()
+	client.routeResponse(&A2AResponse{MessageID: "m2", CorrelationID: "shared"})
+	select {
+	case <-second:
+	default:
+		t.Error("second request lost its entry when the first unregistered")
+	}
+	unregisterSecond()
+	if n := client.pendingRequests(); n != 0 {
+		t.Errorf("%d requests still pending", n)
+	}
+}